@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Outputs receives the generated files. It writes them, or in check mode
+// leaves them alone and reports how they differ from the files on disk.
+// It is safe for concurrent use.
+type Outputs struct {
+	Check bool
+	Diff  io.Writer // Where the unified diffs are printed in check mode.
+	Stale []string  // The files that differ, in check mode.
+
+	mu sync.Mutex // Guards Diff and Stale.
+}
+
+// write writes the contents of the named file, or in check mode compares
+// them with the file on disk. A missing file differs from any contents.
+func (o *Outputs) write(name string, data []byte) error {
+	if !o.Check {
+		return ioutil.WriteFile(name, data, 0644)
+	}
+	old, err := ioutil.ReadFile(name)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil && bytes.Equal(old, data) {
+		return nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(old)),
+		B:        difflib.SplitLines(string(data)),
+		FromFile: name,
+		ToFile:   name + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	fmt.Fprint(o.Diff, diff)
+	o.Stale = append(o.Stale, name)
+	return nil
+}
+
+// writeAll writes the files, in the order of their names.
+func (o *Outputs) writeAll(files map[string][]byte) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := o.write(name, files[name]); err != nil {
+			log.Fatalf("writing output: %s", err)
+		}
+	}
+}
+
+// err returns an error listing the stale files, if check mode found any.
+func (o *Outputs) err() error {
+	if len(o.Stale) == 0 {
+		return nil
+	}
+	sort.Strings(o.Stale)
+	return fmt.Errorf("generated files are out of date, run stringer again: %s", strings.Join(o.Stale, ", "))
+}
+
+// exit exits with an error if check mode found stale files.
+func (o *Outputs) exit() {
+	if err := o.err(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputsCheck(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, "current_string.go")
+	modified := filepath.Join(dir, "modified_string.go")
+	missing := filepath.Join(dir, "missing_string.go")
+	require.Nil(t, ioutil.WriteFile(current, []byte("package p\n\nconst a = 1\n"), 0644))
+	require.Nil(t, ioutil.WriteFile(modified, []byte("package p\n\nconst a = 1\n"), 0644))
+
+	diff := new(bytes.Buffer)
+	out := &Outputs{Check: true, Diff: diff}
+
+	// An up-to-date file is neither printed nor stale.
+	require.Nil(t, out.write(current, []byte("package p\n\nconst a = 1\n")))
+	require.Equal(t, diff.String(), "")
+	require.Nil(t, out.err())
+
+	// A modified file is printed as a unified diff and stale.
+	require.Nil(t, out.write(modified, []byte("package p\n\nconst a = 2\n")))
+	require.Equal(t, diff.String(), strings.Join([]string{
+		"--- " + modified,
+		"+++ " + modified + " (generated)",
+		"@@ -1,4 +1,4 @@",
+		" package p",
+		" ",
+		"-const a = 1",
+		"+const a = 2",
+		" ",
+		"",
+	}, "\n"))
+	require.Equal(t, out.Stale, []string{modified})
+
+	// A missing file is stale.
+	require.Nil(t, out.write(missing, []byte("package p\n")))
+	require.Equal(t, out.Stale, []string{modified, missing})
+	require.Equal(t, out.err().Error(), "generated files are out of date, run stringer again: "+missing+", "+modified)
+
+	// Nothing was written.
+	data, err := ioutil.ReadFile(modified)
+	require.Nil(t, err)
+	require.Equal(t, string(data), "package p\n\nconst a = 1\n")
+	_, err = ioutil.ReadFile(missing)
+	require.NotNil(t, err)
+}
+
+func TestOutputsWrite(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "p_string.go")
+	out := &Outputs{}
+	require.Nil(t, out.write(name, []byte("package p\n")))
+	require.Nil(t, out.err())
+
+	data, err := ioutil.ReadFile(name)
+	require.Nil(t, err)
+	require.Equal(t, string(data), "package p\n")
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lixinio/lxstringer/generator"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFile is the config file looked up in the package directory when
+// neither -type nor -config is given.
+const ConfigFile = ".lxstringer.yaml"
+
+// Config is the content of a config file, which replaces the flags. Paths
+// are relative to the directory of the file.
+//
+//	dir: .
+//	defaults:
+//	  json: true
+//	types:
+//	  - type: OrderStatus
+//	    code2IDFnName: OrderStatusFromCode
+//	    sql: code
+//	  - type: Currency
+//	    skipCode: true
+//	    output: currency_generate.go
+//	doc: enums.md
+type Config struct {
+	Dir               string            `yaml:"dir"` // The package directory, that of the file by default.
+	Tags              []string          `yaml:"tags"`
+	Defaults          generator.Options `yaml:"defaults"` // The options of all the types.
+	Types             []yaml.Node       `yaml:"types"`    // Decoded as TypeConfig over the defaults.
+	generator.Exports `yaml:",inline"`
+
+	types []TypeConfig
+}
+
+// TypeConfig is an entry of the types of a config file. Its options
+// override the defaults.
+type TypeConfig struct {
+	Type              string `yaml:"type"`
+	Output            string `yaml:"output"` // The Go file, <type>_string.go by default. Types with the same output share it.
+	generator.Options `yaml:",inline"`
+}
+
+// loadConfig reads the named config file, resolving its paths.
+// loadConfig exits if there is an error.
+func loadConfig(name string) *Config {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg := new(Config)
+	if err := decodeStrict(data, cfg); err != nil {
+		log.Fatalf("%s: %s", name, err)
+	}
+	if len(cfg.Types) == 0 {
+		log.Fatalf("%s: no types", name)
+	}
+	for i := range cfg.Types {
+		data, err := yaml.Marshal(&cfg.Types[i])
+		if err != nil {
+			log.Fatalf("%s: %s", name, err)
+		}
+		t := TypeConfig{Options: cfg.Defaults}
+		if err := decodeStrict(data, &t); err != nil {
+			log.Fatalf("%s:%d: %s", name, cfg.Types[i].Line, err)
+		}
+		if t.Type == "" {
+			log.Fatalf("%s:%d: no type", name, cfg.Types[i].Line)
+		}
+		cfg.types = append(cfg.types, t)
+	}
+
+	base := filepath.Dir(name)
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(base, path)
+	}
+	cfg.Dir = resolve(cfg.Dir)
+	if cfg.Dir == "" {
+		cfg.Dir = base
+	}
+	for i := range cfg.types {
+		cfg.types[i].Output = resolve(cfg.types[i].Output)
+	}
+	cfg.Proto = resolve(cfg.Proto)
+	cfg.TS = resolve(cfg.TS)
+	cfg.Schema = resolve(cfg.Schema)
+	cfg.GraphQL = resolve(cfg.GraphQL)
+	cfg.Doc = resolve(cfg.Doc)
+	return cfg
+}
+
+// pattern returns the package pattern of the config. A relative directory
+// needs a leading "./" so it is not taken for an import path.
+func (cfg *Config) pattern() string {
+	if filepath.IsAbs(cfg.Dir) || strings.HasPrefix(cfg.Dir, ".") {
+		return cfg.Dir
+	}
+	return "." + string(filepath.Separator) + cfg.Dir
+}
+
+// decodeStrict decodes the YAML document into v, rejecting unknown keys.
+func decodeStrict(data []byte, v interface{}) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	return dec.Decode(v)
+}
+
+// generatorConfig returns the generator config of the file. A type without
+// an output gets its own <type>_string.go.
+func (cfg *Config) generatorConfig() generator.Config {
+	c := generator.Config{
+		Patterns: []string{cfg.pattern()},
+		Tags:     cfg.Tags,
+		Args:     generatedArgs(os.Args[1:]),
+		Exports:  cfg.Exports,
+	}
+	for _, t := range cfg.types {
+		output := t.Output
+		if output == "" {
+			output = filepath.Join(cfg.Dir, strings.ToLower(fmt.Sprintf("%s_string.go", t.Type)))
+		}
+		c.Types = append(c.Types, generator.TypeOptions{Name: t.Type, Output: output, Options: t.Options})
+	}
+	return c
+}
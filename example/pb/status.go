@@ -0,0 +1,31 @@
+// Package pb mimics the enums generated by protoc-gen-go, for the ToPB/FromPB examples.
+package pb
+
+type Status int32
+
+const (
+	Status_STATUS_UNKNOWN  Status = 0
+	Status_STATUS_FROZEN   Status = 1
+	Status_STATUS_UNFROZEN Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNKNOWN",
+		1: "STATUS_FROZEN",
+		2: "STATUS_UNFROZEN",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNKNOWN":  0,
+		"STATUS_FROZEN":   1,
+		"STATUS_UNFROZEN": 2,
+	}
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_LOW  Level = 0
+	Level_LEVEL_HIGH Level = 1
+)
@@ -0,0 +1,9 @@
+package example
+
+type S101 int
+
+const (
+	S101_1 S101 = iota + 1 // frozen 冻结中
+	S101_2                 // unfrozen 已解冻
+	S101_3                 // closed "已 关闭"
+)
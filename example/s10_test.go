@@ -0,0 +1,29 @@
+package example
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lixinio/lxstringer/lxenum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameToS101(t *testing.T) {
+	require.Equal(t, NameToS101("冻结中", S101_3), S101_1)
+	require.Equal(t, NameToS101("已解冻", S101_3), S101_2)
+	require.Equal(t, NameToS101("已 关闭", S101_1), S101_3)
+	require.Equal(t, NameToS101("frozen", S101_3), S101_3)
+
+	s, err := ParseS101Name("已解冻")
+	require.Nil(t, err)
+	require.Equal(t, s, S101_2)
+
+	_, err = ParseS101Name("frozen")
+	var nameErr *lxenum.UnknownNameError
+	require.True(t, errors.As(err, &nameErr))
+	require.Equal(t, nameErr, &lxenum.UnknownNameError{Type: "S101", Name: "frozen"})
+
+	// Names of values in multiple runs are looked up in their own run.
+	require.Equal(t, NameToS52("戊", S52_1), S52_5)
+	require.Equal(t, NameToS52("乙", S52_1), S52_2)
+}
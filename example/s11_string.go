@@ -51,3 +51,25 @@ func CodeToS11(code string, dftVal S11) S11 {
 	}
 	return dftVal
 }
+
+var _S11Name2IDMap = map[string]S11{
+	_S11Name[0:3]:  0,
+	_S11Name[3:6]:  1,
+	_S11Name[6:9]:  2,
+	_S11Name[9:11]: 3,
+}
+
+func NameToS11(name string, dftVal S11) S11 {
+	if val, ok := _S11Name2IDMap[name]; ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S11) IsValid() bool {
+	switch {
+	case 0 <= i && i <= 3:
+		return true
+	}
+	return false
+}
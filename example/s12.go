@@ -0,0 +1,17 @@
+package example
+
+type S121 int
+type S122 int
+
+const (
+	S121_3 S121 = iota + 3 // c 丙
+	S121_1 S121 = iota     // a 甲
+	S121_2 S121 = iota     // b 乙
+	S121_A      = S121_1   // A 甲甲
+)
+
+const (
+	S122_3 S122 = iota + 3 // c 丙
+	S122_1 S122 = iota     // a 甲
+	S122_2 S122 = iota     // b 乙
+)
@@ -0,0 +1,27 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS121Values(t *testing.T) {
+	require.Equal(t, S121Values(), []S121{S121_3, S121_1, S121_2})
+	require.Equal(t, S121Codes(), []string{"c", "a", "b"})
+	require.Equal(t, S121Names(), []string{"丙", "甲", "乙"})
+
+	// Every call returns a fresh slice.
+	values := S121Values()
+	values[0] = S121_1
+	require.Equal(t, S121Values()[0], S121_3)
+	codes := S121Codes()
+	codes[0] = "x"
+	require.Equal(t, S121Codes()[0], "c")
+}
+
+func TestS122Values(t *testing.T) {
+	require.Equal(t, S122Values(), []S122{S122_1, S122_2, S122_3})
+	require.Equal(t, S122Codes(), []string{"a", "b", "c"})
+	require.Equal(t, S122Names(), []string{"甲", "乙", "丙"})
+}
@@ -0,0 +1,10 @@
+package example
+
+type S131 int
+
+const (
+	S131_1 S131 = iota // unknown 未知 @hidden
+	S131_2             // male 男
+	S131_3             // female 女
+	S131_4             // other 其他
+)
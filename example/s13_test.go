@@ -0,0 +1,26 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lixinio/lxstringer/lxenum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS131Options(t *testing.T) {
+	require.Equal(t, S131Options(), []lxenum.Option{
+		{Value: 1, Code: "male", Name: "男"},
+		{Value: 2, Code: "female", Name: "女"},
+		{Value: 3, Code: "other", Name: "其他"},
+	})
+
+	// Hidden values are still declared.
+	require.Equal(t, S131_1.Code(), "unknown")
+	require.Equal(t, S131_1.Name(), "未知")
+	require.True(t, S131_1.IsValid())
+
+	data, err := json.Marshal(S131Options()[:1])
+	require.Nil(t, err)
+	require.Equal(t, string(data), `[{"value":1,"code":"male","name":"男"}]`)
+}
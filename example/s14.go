@@ -0,0 +1,10 @@
+package example
+
+type S141 int
+
+const (
+	S141_1 S141 = iota + 1 // locked 已锁定 color=red icon=lock desc="账户 已被锁定"
+	S141_2                 // active 正常 color=green
+	S141_3                 // closed 已关闭 icon=close @hidden
+	S141_4                 // other 其他
+)
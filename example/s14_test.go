@@ -0,0 +1,26 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS141Meta(t *testing.T) {
+	require.Equal(t, S141_1.Code(), "locked")
+	require.Equal(t, S141_1.Name(), "已锁定")
+	require.Equal(t, S141_1.Meta("color"), "red")
+	require.Equal(t, S141_1.Meta("icon"), "lock")
+	require.Equal(t, S141_1.Meta("desc"), "账户 已被锁定")
+
+	require.Equal(t, S141_2.Meta("color"), "green")
+	require.Equal(t, S141_2.Meta("icon"), "")
+
+	require.Equal(t, S141_3.Code(), "closed")
+	require.Equal(t, S141_3.Meta("icon"), "close")
+	require.Equal(t, S141_3.Meta("color"), "")
+
+	require.Equal(t, S141_4.Meta("color"), "")
+	require.Equal(t, S141_1.Meta("size"), "")
+	require.Equal(t, S141(0).Meta("color"), "")
+}
@@ -0,0 +1,10 @@
+package example
+
+type S151 int
+
+const (
+	S151_1 S151 = iota + 1 // frozen 冻结中 en:"Frozen" ja:"凍結中"
+	S151_2                 // unfrozen en:"Unfrozen" zh:"已解冻"
+	S151_3                 // closed 已关闭 ja:"閉鎖"
+	S151_4                 // other
+)
@@ -0,0 +1,26 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS151NameIn(t *testing.T) {
+	require.Equal(t, S151_1.Name(), "冻结中")
+	require.Equal(t, S151_1.NameIn("zh"), "冻结中")
+	require.Equal(t, S151_1.NameIn("en"), "Frozen")
+	require.Equal(t, S151_1.NameIn("ja"), "凍結中")
+
+	// Without a positional name, the name of the default locale is used.
+	require.Equal(t, S151_2.Code(), "unfrozen")
+	require.Equal(t, S151_2.Name(), "已解冻")
+	require.Equal(t, S151_2.NameIn("en"), "Unfrozen")
+
+	// Missing languages fall back to en, then to Name().
+	require.Equal(t, S151_2.NameIn("ja"), "Unfrozen")
+	require.Equal(t, S151_3.NameIn("en"), "已关闭")
+	require.Equal(t, S151_3.NameIn("fr"), "已关闭")
+	require.Equal(t, S151_4.NameIn("en"), "S151_4")
+	require.Equal(t, S151(0).NameIn("en"), "S151(0)")
+}
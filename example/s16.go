@@ -0,0 +1,12 @@
+package example
+
+type S161 uint8
+
+const (
+	S161_0 S161 = 0         // none 无
+	S161_R S161 = 1 << iota // read 读
+	S161_W                  // write 写
+	S161_X                  // exec 执行
+
+	S161_RW S161 = S161_R | S161_W // rw 读写
+)
@@ -0,0 +1,46 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS161Bitmask(t *testing.T) {
+	require.Equal(t, S161_0.Code(), "none")
+	require.Equal(t, S161_R.Code(), "read")
+	require.Equal(t, S161_RW.Code(), "rw")
+	require.Equal(t, (S161_R | S161_X).Code(), "read|exec")
+	require.Equal(t, (S161_R | S161_W | S161_X).Code(), "read|write|exec")
+	require.Equal(t, (S161_W | S161_X).Name(), "写|执行")
+	require.Equal(t, S161(1).Code(), "S161(1)")
+	require.Equal(t, (S161_R | 1).Code(), "S161(3)")
+
+	require.Equal(t, CodeToS161("read|exec", S161_0), S161_R|S161_X)
+	require.Equal(t, CodeToS161("exec|rw", S161_0), S161_R|S161_W|S161_X)
+	require.Equal(t, CodeToS161("read|delete", S161_0), S161_0)
+	require.Equal(t, NameToS161("读|执行", S161_0), S161_R|S161_X)
+
+	s, err := ParseS161((S161_W | S161_X).Code())
+	require.Nil(t, err)
+	require.Equal(t, s, S161_W|S161_X)
+	_, err = ParseS161("read|")
+	require.NotNil(t, err)
+
+	require.True(t, S161_0.IsValid())
+	require.True(t, (S161_R | S161_X).IsValid())
+	require.False(t, S161(1).IsValid())
+	require.False(t, S161(16).IsValid())
+	require.False(t, (S161_R | 16).IsValid())
+}
+
+func TestS161JSON(t *testing.T) {
+	data, err := json.Marshal(S161_R | S161_X)
+	require.Nil(t, err)
+	require.Equal(t, string(data), `"read|exec"`)
+
+	var s S161
+	require.Nil(t, json.Unmarshal([]byte(`"write|exec"`), &s))
+	require.Equal(t, s, S161_W|S161_X)
+}
@@ -0,0 +1,18 @@
+package example
+
+type FrozenStatus int16
+
+const (
+	FrozenStatusUnknown  FrozenStatus = iota // unknown 未知
+	FrozenStatusFreezing                     // freezing 冻结中
+	FrozenStatusUnfreeze                     // unfreeze 已解冻
+)
+
+type S172 int
+
+const (
+	S172_2 S172 = iota - 1 // minus "负 一"
+	S172_0                 // zero 零
+	S172_1                 // oneOne 一
+	S172_3 S172 = 10       // 十 十
+)
@@ -0,0 +1,15 @@
+package example
+
+type S181 int
+type S182 int
+
+const (
+	S181_1 S181 = iota // unknown 未知
+	S181_2             // frozen 冻结
+	S181_3             // unfrozen 解冻
+)
+
+const (
+	S182Low  S182 = iota + 1 // l 低
+	S182High                 // h 高
+)
@@ -0,0 +1,100 @@
+// Code generated by "stringer -type=S181 -pb=github.com/lixinio/lxstringer/example/pb.Status example/s18.go"; DO NOT EDIT.
+
+package example
+
+import (
+	"strconv"
+
+	"github.com/lixinio/lxstringer/example/pb"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[S181_1-0]
+	_ = x[S181_2-1]
+	_ = x[S181_3-2]
+}
+
+const (
+	_S181CodeName = "unknownfrozenunfrozen"
+	_S181Name     = "未知冻结解冻"
+)
+
+var (
+	_S181CodeIndex = [...]uint8{0, 7, 13, 21}
+	_S181NameIndex = [...]uint8{0, 6, 12, 18}
+)
+
+func (i S181) Code() string {
+	if i < 0 || i >= S181(len(_S181CodeIndex)-1) {
+		return "S181(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _S181CodeName[_S181CodeIndex[i]:_S181CodeIndex[i+1]]
+}
+
+func (i S181) Name() string {
+	if i < 0 || i >= S181(len(_S181NameIndex)-1) {
+		return "S181(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _S181Name[_S181NameIndex[i]:_S181NameIndex[i+1]]
+}
+
+var _S181Code2IDMap = map[string]S181{
+	_S181CodeName[0:7]:   0,
+	_S181CodeName[7:13]:  1,
+	_S181CodeName[13:21]: 2,
+}
+
+func CodeToS181(code string, dftVal S181) S181 {
+	if val, ok := _S181Code2IDMap[code]; ok {
+		return val
+	}
+	return dftVal
+}
+
+var _S181Name2IDMap = map[string]S181{
+	_S181Name[0:6]:   0,
+	_S181Name[6:12]:  1,
+	_S181Name[12:18]: 2,
+}
+
+func NameToS181(name string, dftVal S181) S181 {
+	if val, ok := _S181Name2IDMap[name]; ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S181) IsValid() bool {
+	switch {
+	case 0 <= i && i <= 2:
+		return true
+	}
+	return false
+}
+
+func (i S181) ToPB(dftVal pb.Status) pb.Status {
+	switch i {
+	case S181_1:
+		return pb.Status_STATUS_UNKNOWN
+	case S181_2:
+		return pb.Status_STATUS_FROZEN
+	case S181_3:
+		return pb.Status_STATUS_UNFROZEN
+	}
+	return dftVal
+}
+
+func S181FromPB(v pb.Status, dftVal S181) S181 {
+	switch v {
+	case pb.Status_STATUS_UNKNOWN:
+		return S181_1
+	case pb.Status_STATUS_FROZEN:
+		return S181_2
+	case pb.Status_STATUS_UNFROZEN:
+		return S181_3
+	}
+	return dftVal
+}
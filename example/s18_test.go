@@ -0,0 +1,30 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/lixinio/lxstringer/example/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS181PB(t *testing.T) {
+	require.Equal(t, S181_1.ToPB(pb.Status_STATUS_FROZEN), pb.Status_STATUS_UNKNOWN)
+	require.Equal(t, S181_2.ToPB(pb.Status_STATUS_UNKNOWN), pb.Status_STATUS_FROZEN)
+	require.Equal(t, S181_3.ToPB(pb.Status_STATUS_UNKNOWN), pb.Status_STATUS_UNFROZEN)
+
+	require.Equal(t, S181FromPB(pb.Status_STATUS_UNKNOWN, S181_2), S181_1)
+	require.Equal(t, S181FromPB(pb.Status_STATUS_FROZEN, S181_1), S181_2)
+	require.Equal(t, S181FromPB(pb.Status_STATUS_UNFROZEN, S181_1), S181_3)
+}
+
+func TestS182PB(t *testing.T) {
+	// Matched by constant name, the codes are unrelated.
+	require.Equal(t, S182Low.ToPB(pb.Level_LEVEL_HIGH), pb.Level_LEVEL_LOW)
+	require.Equal(t, S182High.ToPB(pb.Level_LEVEL_LOW), pb.Level_LEVEL_HIGH)
+	require.Equal(t, S182FromPB(pb.Level_LEVEL_HIGH, S182Low), S182High)
+
+	// Undeclared values convert to the default: S182(0) is not declared,
+	// while pb.Level(0) is LEVEL_LOW.
+	require.Equal(t, S182(0).ToPB(pb.Level(-1)), pb.Level(-1))
+	require.Equal(t, S182FromPB(pb.Level(5), S182Low), S182Low)
+}
@@ -0,0 +1,16 @@
+package example
+
+type S191 int
+type S192 uint8
+
+const (
+	S191_1 S191 = iota - 1 // deleted 已删除
+	S191_2                 // draft 草稿
+	S191_3                 // published "已 发布"
+	S191_4 S191 = 10       // "in review" 审核中
+)
+
+const (
+	S192_1 S192 = iota + 1 // a 甲
+	S192_2                 // b 乙
+)
@@ -24,3 +24,10 @@ func TestS11(t *testing.T) {
 	require.Equal(t, CodeToS11("F发 生", S11_1), S11_3)
 	require.Equal(t, CodeToS11("D", S11_1), S11_4)
 }
+
+func TestS11IsValid(t *testing.T) {
+	require.True(t, S11_1.IsValid())
+	require.True(t, S11_5.IsValid())
+	require.False(t, S11(-1).IsValid())
+	require.False(t, S11(4).IsValid())
+}
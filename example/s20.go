@@ -0,0 +1,15 @@
+package example
+
+type S201 int
+type S202 int
+
+const (
+	S201_1 S201 = iota + 1 // pending 待支付
+	S201_2                 // paid 已支付
+	S201_3                 // refunded 已退款
+)
+
+const (
+	S202_1 S202 = iota // cny 人民币
+	S202_2             // usd 美元
+)
@@ -0,0 +1,10 @@
+package example
+
+type S211 int
+
+const (
+	S211_1 S211 = iota // unknown 未知
+	S211_2             // inReview 审核中
+	S211_3             // "2fa required" 需要二次验证
+	S211_4             // 通过 已通过
+)
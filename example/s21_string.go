@@ -50,6 +50,27 @@ func CodeToS21(code string, dftVal S21) S21 {
 	return dftVal
 }
 
+var _S21Name2IDMap = map[string]S21{
+	_S21Name[0:3]: 0,
+	_S21Name[3:6]: 1,
+	_S21Name[6:9]: 2,
+}
+
+func NameToS21(name string, dftVal S21) S21 {
+	if val, ok := _S21Name2IDMap[name]; ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S21) IsValid() bool {
+	switch {
+	case 0 <= i && i <= 2:
+		return true
+	}
+	return false
+}
+
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
@@ -97,3 +118,24 @@ func CodeToS22(code string, dftVal S22) S22 {
 	}
 	return dftVal
 }
+
+var _S22Name2IDMap = map[string]S22{
+	_S22Name[0:5]:   100,
+	_S22Name[5:12]:  101,
+	_S22Name[12:18]: 102,
+}
+
+func NameToS22(name string, dftVal S22) S22 {
+	if val, ok := _S22Name2IDMap[name]; ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S22) IsValid() bool {
+	switch {
+	case 100 <= i && i <= 102:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package example
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS211GQL(t *testing.T) {
+	var b bytes.Buffer
+	S211_2.MarshalGQL(&b)
+	require.Equal(t, b.String(), `"IN_REVIEW"`)
+	b.Reset()
+	S211_3.MarshalGQL(&b)
+	require.Equal(t, b.String(), `"_2FA_REQUIRED"`)
+	b.Reset()
+	S211_4.MarshalGQL(&b)
+	require.Equal(t, b.String(), `"S211_4"`)
+	b.Reset()
+	// Undeclared values have no symbol.
+	S211(9).MarshalGQL(&b)
+	require.Equal(t, b.String(), "null")
+
+	var s S211
+	require.Nil(t, s.UnmarshalGQL("_2FA_REQUIRED"))
+	require.Equal(t, s, S211_3)
+	require.Nil(t, s.UnmarshalGQL("UNKNOWN"))
+	require.Equal(t, s, S211_1)
+
+	// Codes aren't GraphQL symbols.
+	require.NotNil(t, s.UnmarshalGQL("inReview"))
+	require.NotNil(t, s.UnmarshalGQL(1))
+	require.Equal(t, s, S211_1)
+}
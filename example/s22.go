@@ -0,0 +1,22 @@
+package example
+
+// S221 订单状态
+type S221 int
+
+// S222 is the delivery channel of a notification.
+//
+// The channels are tried in the order of their values.
+type S222 uint8
+
+const (
+	S221_1 S221 = iota + 1 // pending 待支付 下单后30分钟内未支付自动取消 color=orange
+	S221_2                 // paid 已支付 color=green
+	S221_3                 // refunded 已退款 "可部分退款|全额退款" color=gray
+	S221_9 S221 = 9        // closed 已关闭
+)
+
+const (
+	S222_1 S222 = iota // sms 短信
+	S222_2             // email 邮件
+	S222_3             // push 推送
+)
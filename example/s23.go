@@ -0,0 +1,17 @@
+package example
+
+// S231 支付方式
+type S231 int
+
+type S232 int
+
+const (
+	S231_1 S231 = iota + 1 // alipay 支付宝
+	S231_2                 // wechat 微信支付
+	S231_3                 // card 银行卡
+)
+
+const (
+	S232_1 S232 = iota // 草稿
+	S232_2             // 已发布
+)
@@ -0,0 +1,32 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS231(t *testing.T) {
+	require.Equal(t, S231_2.CodeName(), "wechat")
+	require.Equal(t, S231_2.Label(), "微信支付")
+	require.Equal(t, S231FromCode("card", S231_1), S231_3)
+
+	data, err := json.Marshal(S231_1)
+	require.Nil(t, err)
+	require.Equal(t, string(data), `"alipay"`)
+
+	v, err := S231_3.Value()
+	require.Nil(t, err)
+	require.Equal(t, v, "card")
+}
+
+func TestS232(t *testing.T) {
+	require.Equal(t, S232_2.Code(), "已发布")
+	require.Equal(t, S232_2.Name(), "已发布")
+	require.Equal(t, NameToS232("草稿", S232_2), S232_1)
+
+	var s S232
+	require.Nil(t, json.Unmarshal([]byte(`"已发布"`), &s))
+	require.Equal(t, s, S232_2)
+}
@@ -0,0 +1,21 @@
+package example
+
+// S241 审核状态
+//
+//lxstringer:enum code=Code name=Label code2id=- parse json sql=code
+type S241 int
+
+//lxstringer:enum skipcode nametoid=S242FromName sep=" / " bitmask
+type S242 uint8
+
+const (
+	S241_1 S241 = iota // pending 待审核
+	S241_2             // approved 已通过
+	S241_3             // rejected 已拒绝
+)
+
+const (
+	S242_1 S242 = 1 << iota // 读
+	S242_2                  // 写
+	S242_3                  // 执行
+)
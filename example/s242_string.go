@@ -0,0 +1,139 @@
+// Code generated by "stringer example/s24.go"; DO NOT EDIT.
+
+package example
+
+import (
+	"strconv"
+	"strings"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[S242_1-1]
+	_ = x[S242_2-2]
+	_ = x[S242_3-4]
+}
+
+const (
+	_S242CodeName = "读写执行"
+	_S242Name     = "读写执行"
+)
+
+var _S242CodeMap = map[S242]string{
+	1: _S242CodeName[0:3],
+	2: _S242CodeName[3:6],
+	4: _S242CodeName[6:12],
+}
+
+var _S242NameMap = map[S242]string{
+	1: _S242Name[0:3],
+	2: _S242Name[3:6],
+	4: _S242Name[6:12],
+}
+
+var _S242Bits = [...]S242{1, 2, 4}
+
+func (i S242) Code() string {
+	if str, ok := _S242CodeMap[i]; ok {
+		return str
+	}
+	var parts []string
+	rest := i
+	for _, bit := range _S242Bits {
+		if rest&bit != 0 {
+			parts = append(parts, _S242CodeMap[bit])
+			rest &^= bit
+		}
+	}
+	if len(parts) == 0 || rest != 0 {
+		return "S242(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (i S242) Name() string {
+	if str, ok := _S242NameMap[i]; ok {
+		return str
+	}
+	var parts []string
+	rest := i
+	for _, bit := range _S242Bits {
+		if rest&bit != 0 {
+			parts = append(parts, _S242NameMap[bit])
+			rest &^= bit
+		}
+	}
+	if len(parts) == 0 || rest != 0 {
+		return "S242(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return strings.Join(parts, " / ")
+}
+
+var _S242Code2IDMap = map[string]S242{
+	_S242CodeName[0:3]:  1,
+	_S242CodeName[3:6]:  2,
+	_S242CodeName[6:12]: 4,
+}
+
+func _S242ParseCodes(str string) (S242, bool) {
+	if val, ok := _S242Code2IDMap[str]; ok {
+		return val, true
+	}
+	var val S242
+	for _, part := range strings.Split(str, " / ") {
+		v, ok := _S242Code2IDMap[part]
+		if !ok {
+			return 0, false
+		}
+		val |= v
+	}
+	return val, true
+}
+
+func CodeToS242(code string, dftVal S242) S242 {
+	if val, ok := _S242ParseCodes(code); ok {
+		return val
+	}
+	return dftVal
+}
+
+var _S242Name2IDMap = map[string]S242{
+	_S242Name[0:3]:  1,
+	_S242Name[3:6]:  2,
+	_S242Name[6:12]: 4,
+}
+
+func _S242ParseNames(str string) (S242, bool) {
+	if val, ok := _S242Name2IDMap[str]; ok {
+		return val, true
+	}
+	var val S242
+	for _, part := range strings.Split(str, " / ") {
+		v, ok := _S242Name2IDMap[part]
+		if !ok {
+			return 0, false
+		}
+		val |= v
+	}
+	return val, true
+}
+
+func S242FromName(name string, dftVal S242) S242 {
+	if val, ok := _S242ParseNames(name); ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S242) IsValid() bool {
+	if _, ok := _S242CodeMap[i]; ok {
+		return true
+	}
+	rest := i
+	for _, bit := range _S242Bits {
+		rest &^= bit
+	}
+	return i != 0 && rest == 0
+}
@@ -0,0 +1,30 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS241Directive(t *testing.T) {
+	require.Equal(t, S241_2.Code(), "approved")
+	require.Equal(t, S241_2.Label(), "已通过")
+	require.Equal(t, MustParseS241("rejected"), S241_3)
+
+	data, err := json.Marshal(S241_1)
+	require.Nil(t, err)
+	require.Equal(t, string(data), `"pending"`)
+
+	v, err := S241_3.Value()
+	require.Nil(t, err)
+	require.Equal(t, v, "rejected")
+}
+
+func TestS242Directive(t *testing.T) {
+	require.Equal(t, S242_1.Code(), "读")
+	require.Equal(t, S242_1.Name(), "读")
+	require.Equal(t, (S242_1 | S242_3).Code(), "读 / 执行")
+	require.Equal(t, S242FromName("写 / 执行", 0), S242_2|S242_3)
+	require.Equal(t, CodeToS242("读 / 写", 0), S242_1|S242_2)
+}
@@ -0,0 +1,10 @@
+package example
+
+type S251 int
+
+const (
+	S251_1 S251 = iota + 1 // wechat 微信支付
+	S251_2                 // alipay 支付宝
+	S251_3                 // wxpay 微信支付 @alias
+	S251_4                 // wechat 微信小程序 @alias
+)
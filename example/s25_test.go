@@ -0,0 +1,21 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS251Alias(t *testing.T) {
+	require.Equal(t, S251_4.Code(), "wechat")
+	require.Equal(t, S251_4.Name(), "微信小程序")
+	require.Equal(t, CodeToS251("wechat", 0), S251_1)
+	require.Equal(t, CodeToS251("wxpay", 0), S251_3)
+	require.Equal(t, NameToS251("微信支付", 0), S251_1)
+	require.Equal(t, NameToS251("微信小程序", 0), S251_4)
+
+	var v S251
+	require.Nil(t, json.Unmarshal([]byte(`"wechat"`), &v))
+	require.Equal(t, v, S251_1)
+}
@@ -74,3 +74,20 @@ func TestS33(t *testing.T) {
 	require.Equal(t, CodeToS33("中 华4", S33_1), S33_11)
 	require.Equal(t, CodeToS33("啊`啊4", S33_1), S33_12)
 }
+
+func TestS3IsValid(t *testing.T) {
+	require.True(t, S31_2.IsValid())
+	require.False(t, S31(1).IsValid())
+	require.False(t, S31(6).IsValid())
+
+	require.True(t, S32_3.IsValid())
+	require.False(t, S32(101).IsValid())
+
+	// S33 is sparse enough to be backed by a map.
+	for _, s := range []S33{S33_1, S33_2, S33_6, S33_12} {
+		require.True(t, s.IsValid())
+	}
+	for _, s := range []S33{0, 2, 4, S33_12 - 1, S33_12 + 1} {
+		require.False(t, s.IsValid())
+	}
+}
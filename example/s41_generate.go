@@ -51,3 +51,24 @@ func S41FromCode(code string, dftVal S41) S41 {
 	}
 	return dftVal
 }
+
+var _S41Name2IDMap = map[string]S41{
+	_S41Name[0:5]:   100,
+	_S41Name[5:12]:  101,
+	_S41Name[12:18]: 102,
+}
+
+func NameToS41(name string, dftVal S41) S41 {
+	if val, ok := _S41Name2IDMap[name]; ok {
+		return val
+	}
+	return dftVal
+}
+
+func (i S41) IsValid() bool {
+	switch {
+	case 100 <= i && i <= 102:
+		return true
+	}
+	return false
+}
@@ -19,3 +19,10 @@ func TestS41(t *testing.T) {
 	require.Equal(t, S41FromCode("中 华", S41_1), S41_2)
 	require.Equal(t, S41FromCode("啊`啊", S41_1), S41_3)
 }
+
+func TestS41IsValid(t *testing.T) {
+	require.True(t, S41_1.IsValid())
+	require.True(t, S41_3.IsValid())
+	require.False(t, S41(99).IsValid())
+	require.False(t, S41(103).IsValid())
+}
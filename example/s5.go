@@ -0,0 +1,18 @@
+package example
+
+type S51 int
+type S52 int
+
+const (
+	S51_1 S51 = iota // unknown 未知
+	S51_2            // freezing 冻结中
+	S51_3            // unfreeze 已解冻
+)
+
+const (
+	S52_1 S52 = iota     // a 甲
+	S52_2                // b 乙
+	S52_3                // c 丙
+	S52_5 S52 = iota + 2 // e 戊
+	S52_6                // f 己
+)
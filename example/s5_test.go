@@ -0,0 +1,54 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS51JSON(t *testing.T) {
+	data, err := json.Marshal(S51_2)
+	require.Nil(t, err)
+	require.Equal(t, string(data), `"freezing"`)
+
+	var s S51
+	require.Nil(t, json.Unmarshal([]byte(`"unfreeze"`), &s))
+	require.Equal(t, s, S51_3)
+
+	require.NotNil(t, json.Unmarshal([]byte(`"frozen"`), &s))
+	require.NotNil(t, json.Unmarshal([]byte(`2`), &s))
+	require.Equal(t, s, S51_3)
+
+	var v struct {
+		Status S51 `json:"status"`
+	}
+	require.Nil(t, json.Unmarshal([]byte(`{"status":"unknown"}`), &v))
+	require.Equal(t, v.Status, S51_1)
+}
+
+func TestS52JSON(t *testing.T) {
+	data, err := json.Marshal([]S52{S52_1, S52_3, S52_5, S52_6})
+	require.Nil(t, err)
+	require.Equal(t, string(data), `["a","c","e","f"]`)
+
+	var s []S52
+	require.Nil(t, json.Unmarshal([]byte(`["f","b","e"]`), &s))
+	require.Equal(t, s, []S52{S52_6, S52_2, S52_5})
+
+	// Undeclared values have no code to encode.
+	_, err = json.Marshal(S52(4))
+	require.NotNil(t, err)
+
+	require.Equal(t, CodeToS52("e", S52_1), S52_5)
+	require.Equal(t, CodeToS52("ef", S52_1), S52_1)
+}
+
+func TestS52IsValid(t *testing.T) {
+	for _, s := range []S52{S52_1, S52_2, S52_3, S52_5, S52_6} {
+		require.True(t, s.IsValid())
+	}
+	for _, s := range []S52{-1, 3, 4, 7} {
+		require.False(t, s.IsValid())
+	}
+}
@@ -0,0 +1,9 @@
+package example
+
+type S61 int
+
+const (
+	S61_1 S61 = iota + 1 // pending 待处理
+	S61_2                // approved 已通过
+	S61_3                // rejected 已拒绝
+)
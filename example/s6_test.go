@@ -0,0 +1,36 @@
+package example
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS61Text(t *testing.T) {
+	var _ encoding.TextMarshaler = S61_1
+	var _ encoding.TextUnmarshaler = new(S61)
+
+	text, err := S61_2.MarshalText()
+	require.Nil(t, err)
+	require.Equal(t, string(text), "approved")
+
+	_, err = S61(0).MarshalText()
+	require.NotNil(t, err)
+
+	var s S61
+	require.Nil(t, s.UnmarshalText([]byte("rejected")))
+	require.Equal(t, s, S61_3)
+	require.NotNil(t, s.UnmarshalText([]byte("unknown")))
+	require.Equal(t, s, S61_3)
+
+	// encoding/json uses the text form for map keys and plain strings.
+	data, err := json.Marshal(map[S61]int{S61_1: 1, S61_3: 3})
+	require.Nil(t, err)
+	require.Equal(t, string(data), `{"pending":1,"rejected":3}`)
+
+	var m map[S61]int
+	require.Nil(t, json.Unmarshal([]byte(`{"approved":2}`), &m))
+	require.Equal(t, m, map[S61]int{S61_2: 2})
+}
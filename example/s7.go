@@ -0,0 +1,23 @@
+package example
+
+type S71 int
+type S72 uint8
+type S73 int
+
+const (
+	S71_1 S71 = iota + 1 // created 已创建
+	S71_2                // paid 已支付
+	S71_3                // shipped 已发货
+)
+
+const (
+	S72_1 S72 = iota     // a 甲
+	S72_2                // b 乙
+	S72_4 S72 = iota + 1 // d 丁
+)
+
+const (
+	S73_1 S73 = 1 << iota // r 读
+	S73_2                 // w 写
+	S73_3                 // x 执行
+)
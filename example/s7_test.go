@@ -0,0 +1,63 @@
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS71SQL(t *testing.T) {
+	var _ sql.Scanner = new(S71)
+	var _ driver.Valuer = S71_1
+
+	v, err := S71_2.Value()
+	require.Nil(t, err)
+	require.Equal(t, v, int64(2))
+	_, err = S71(0).Value()
+	require.NotNil(t, err)
+
+	var s S71
+	require.Nil(t, s.Scan(int64(3)))
+	require.Equal(t, s, S71_3)
+	require.Nil(t, s.Scan([]byte("1")))
+	require.Equal(t, s, S71_1)
+	require.Nil(t, s.Scan("2"))
+	require.Equal(t, s, S71_2)
+
+	require.NotNil(t, s.Scan(int64(4)))
+	require.NotNil(t, s.Scan("paid"))
+	require.NotNil(t, s.Scan(nil))
+	require.Equal(t, s, S71_2)
+}
+
+func TestS72SQL(t *testing.T) {
+	v, err := S72_4.Value()
+	require.Nil(t, err)
+	require.Equal(t, v, "d")
+	_, err = S72(2).Value()
+	require.NotNil(t, err)
+
+	var s S72
+	require.Nil(t, s.Scan("b"))
+	require.Equal(t, s, S72_2)
+	require.Nil(t, s.Scan([]byte("d")))
+	require.Equal(t, s, S72_4)
+	require.Nil(t, s.Scan(int64(0)))
+	require.Equal(t, s, S72_1)
+
+	require.NotNil(t, s.Scan("c"))
+	require.NotNil(t, s.Scan(int64(2)))
+	require.NotNil(t, s.Scan(int64(259)))
+	require.Equal(t, s, S72_1)
+}
+
+func TestS73SQL(t *testing.T) {
+	var s S73
+	require.Nil(t, s.Scan(int64(4)))
+	require.Equal(t, s, S73_3)
+	require.NotNil(t, s.Scan(int64(3)))
+	require.NotNil(t, s.Scan("x"))
+	require.Equal(t, s, S73_3)
+}
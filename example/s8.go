@@ -0,0 +1,16 @@
+package example
+
+type S81 int
+
+//lxstringer:enum json
+type S82 int
+
+const (
+	S81_1 S81 = iota + 1 // frozen 冻结
+	S81_2                // active 正常
+)
+
+const (
+	S82_1 S82 = iota // low 低
+	S82_2            // high 高
+)
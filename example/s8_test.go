@@ -0,0 +1,61 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullS81(t *testing.T) {
+	var n NullS81
+	require.Equal(t, n.Code(), "")
+	require.Equal(t, n.Name(), "")
+	v, err := n.Value()
+	require.Nil(t, err)
+	require.Nil(t, v)
+
+	require.Nil(t, n.Scan(int64(2)))
+	require.Equal(t, n, NullS81{S81: S81_2, Valid: true})
+	require.Equal(t, n.Code(), "active")
+	require.Equal(t, n.Name(), "正常")
+	v, err = n.Value()
+	require.Nil(t, err)
+	require.Equal(t, v, int64(2))
+
+	require.NotNil(t, n.Scan(int64(3)))
+	require.Equal(t, n, NullS81{S81: S81_2, Valid: true})
+	require.Nil(t, n.Scan(nil))
+	require.Equal(t, n, NullS81{})
+
+	// Without -json, S81 and NullS81 are encoded as integers.
+	data, err := json.Marshal([]NullS81{{}, {S81: S81_2, Valid: true}})
+	require.Nil(t, err)
+	require.Equal(t, string(data), `[null,2]`)
+	var ns []NullS81
+	require.Nil(t, json.Unmarshal([]byte(`[1,null]`), &ns))
+	require.Equal(t, ns, []NullS81{{S81: S81_1, Valid: true}, {}})
+}
+
+func TestNullS82JSON(t *testing.T) {
+	var v struct {
+		A NullS82 `json:"a"`
+		B NullS82 `json:"b"`
+	}
+	v.B = NullS82{S82: S82_2, Valid: true}
+	data, err := json.Marshal(v)
+	require.Nil(t, err)
+	require.Equal(t, string(data), `{"a":null,"b":"high"}`)
+
+	require.Nil(t, json.Unmarshal([]byte(`{"a":"low","b":null}`), &v))
+	require.Equal(t, v.A, NullS82{S82: S82_1, Valid: true})
+	require.Equal(t, v.B, NullS82{})
+	require.NotNil(t, json.Unmarshal([]byte(`{"a":"middle"}`), &v))
+
+	var n NullS82
+	require.Nil(t, n.Scan([]byte("high")))
+	require.Equal(t, n, NullS82{S82: S82_2, Valid: true})
+	value, err := n.Value()
+	require.Nil(t, err)
+	require.Equal(t, value, "high")
+}
@@ -0,0 +1,16 @@
+package example
+
+type S91 int
+type S92 int
+
+const (
+	S91_1 S91 = iota // debug 调试
+	S91_2            // info 信息
+	S91_3            // warn 警告
+	S91_4            // error 错误
+)
+
+const (
+	S92_1 S92 = iota + 1 // dev 开发
+	S92_2                // prod 生产
+)
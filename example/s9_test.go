@@ -0,0 +1,49 @@
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lixinio/lxstringer/lxenum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseS91(t *testing.T) {
+	s, err := ParseS91("warn")
+	require.Nil(t, err)
+	require.Equal(t, s, S91_3)
+
+	// The default value can't be told apart from an unknown code with CodeTo.
+	require.Equal(t, CodeToS91("debug", S91_1), S91_1)
+	require.Equal(t, CodeToS91("fatal", S91_1), S91_1)
+	_, err = ParseS91("fatal")
+	var codeErr *lxenum.UnknownCodeError
+	require.True(t, errors.As(err, &codeErr))
+	require.Equal(t, codeErr, &lxenum.UnknownCodeError{Type: "S91", Code: "fatal"})
+	require.Equal(t, err.Error(), `unknown S91 code "fatal"`)
+
+	require.Equal(t, MustParseS91("error"), S91_4)
+	require.PanicsWithError(t, `unknown S91 code "fatal"`, func() { MustParseS91("fatal") })
+}
+
+func TestParseS92(t *testing.T) {
+	s, err := S92FromCode("prod")
+	require.Nil(t, err)
+	require.Equal(t, s, S92_2)
+	_, err = S92FromCode("test")
+	require.NotNil(t, err)
+}
+
+func TestUnknownCodeError(t *testing.T) {
+	var s S51
+	err := json.Unmarshal([]byte(`"frozen"`), &s)
+	var codeErr *lxenum.UnknownCodeError
+	require.True(t, errors.As(err, &codeErr))
+	require.Equal(t, codeErr.Code, "frozen")
+
+	var s6 S61
+	err = s6.UnmarshalText([]byte("unknown"))
+	require.True(t, errors.As(err, &codeErr))
+	require.Equal(t, codeErr.Type, "S61")
+}
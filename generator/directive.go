@@ -0,0 +1,159 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Directive starts the comment on a type declaration that configures the
+// type, as in
+//
+//	//lxstringer:enum code=Code name=Label code2id=- skipcode json sql=code
+//
+// The keys are the names of the lxstringer flags; a boolean flag may leave out
+// the value.
+const Directive = "//lxstringer:enum"
+
+// directive is the configuration of an annotated type.
+type directive struct {
+	typeName string
+	args     [][2]string // The key and value pairs, in order.
+}
+
+// directiveRe matches the fields of a directive, which may be quoted as in sep=" | ".
+var directiveRe = regexp.MustCompile(`[^\s"]*"[^"]*"|[^\s"]+`)
+
+// parseDirective returns the directive in the comments of a type, or nil if
+// there is none, and the position of the directive comment for reporting its
+// problems.
+func parseDirective(typeName string, doc *ast.CommentGroup) (*directive, token.Pos, error) {
+	if doc == nil {
+		return nil, token.NoPos, nil
+	}
+	for _, c := range doc.List {
+		if c.Text != Directive && !strings.HasPrefix(c.Text, Directive+" ") {
+			continue
+		}
+		d := &directive{typeName: typeName}
+		for _, field := range directiveRe.FindAllString(c.Text[len(Directive):], -1) {
+			key, value := field, ""
+			if i := strings.Index(field, "="); i >= 0 {
+				key, value = field[:i], strings.Trim(field[i+1:], "\"")
+			}
+			d.args = append(d.args, [2]string{key, value})
+		}
+		// Check the arguments before they are applied.
+		var opts Options
+		if err := opts.apply(d); err != nil {
+			return nil, c.Slash, err
+		}
+		return d, c.Slash, nil
+	}
+	return nil, token.NoPos, nil
+}
+
+// apply sets the options given by the directive.
+func (o *Options) apply(d *directive) error {
+	for _, arg := range d.args {
+		key, value := arg[0], arg[1]
+		var s *string
+		var b *bool
+		switch key {
+		case "code":
+			s = &o.CodeFnName
+		case "name":
+			s = &o.NameFnName
+		case "code2id":
+			s = &o.Code2IDFnName
+		case "isvalid":
+			s = &o.IsValidFnName
+		case "meta":
+			s = &o.MetaFnName
+		case "namein":
+			s = &o.NameInFnName
+		case "locale":
+			s = &o.Locale
+		case "parsefn":
+			s = &o.ParseFnName
+		case "mustparse":
+			s = &o.MustParseName
+		case "nametoid":
+			s = &o.Name2IDFnName
+		case "parsename":
+			s = &o.ParseNameFn
+		case "sql":
+			s = &o.SQLStorage
+		case "values":
+			s = &o.ValuesOrder
+		case "sep":
+			s = &o.Separator
+		case "pb":
+			s = &o.PBTarget
+		case "pbmatch":
+			s = &o.PBMatch
+		case "fallback":
+			o.Fallback = strings.Split(value, ",")
+			continue
+		case "skipcode":
+			b = &o.SkipCode
+		case "json":
+			b = &o.GenJSON
+		case "text":
+			b = &o.GenText
+		case "parse":
+			b = &o.GenParse
+		case "null":
+			b = &o.GenNull
+		case "options":
+			b = &o.GenOptions
+		case "bitmask":
+			b = &o.Bitmask
+		case "gqlgen":
+			b = &o.GenGQL
+		default:
+			return fmt.Errorf("unknown key %q in %s of type %s", key, Directive, d.typeName)
+		}
+		if s != nil {
+			if value == "" {
+				return fmt.Errorf("key %q in %s of type %s needs a value", key, Directive, d.typeName)
+			}
+			*s = value
+			continue
+		}
+		*b = true
+		if value != "" {
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value %q of key %q in %s of type %s", value, key, Directive, d.typeName)
+			}
+			*b = v
+		}
+	}
+	return nil
+}
+
+// typeSpecs calls fn for each type declared by the file, with the comments
+// documenting it. An ungrouped declaration attaches them to the GenDecl.
+func (f *parsedFile) typeSpecs(fn func(tspec *ast.TypeSpec, doc *ast.CommentGroup)) {
+	if f.file == nil {
+		return
+	}
+	for _, decl := range f.file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			tspec := spec.(*ast.TypeSpec) // Guaranteed to succeed as this is TYPE.
+			doc := tspec.Doc
+			if doc == nil && !decl.Lparen.IsValid() {
+				doc = decl.Doc
+			}
+			fn(tspec, doc)
+		}
+	}
+}
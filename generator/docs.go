@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"html/template"
+	"sort"
+	"strings"
+)
+
+// Formats of the DocFormat export.
+const (
+	DocMarkdown = "markdown"
+	DocHTML     = "html"
+)
+
+// docTable is the documentation of a generated type: a heading and a table
+// with one row per value.
+type docTable struct {
+	Name    string
+	Heading string
+	Columns []string
+	Rows    [][]string
+}
+
+// typeDoc returns the doc comment of the named type on a single line, or ""
+// if it has none.
+func (g *generator) typeDoc(typeName string) string {
+	doc := ""
+	for _, file := range g.pkg.files {
+		file.typeSpecs(func(tspec *ast.TypeSpec, comments *ast.CommentGroup) {
+			if tspec.Name.Name != typeName {
+				return
+			}
+			if comments == nil {
+				comments = tspec.Comment
+			}
+			doc = strings.Join(strings.Fields(comments.Text()), " ")
+		})
+	}
+	return doc
+}
+
+// docTables returns the tables of the generated types. The extra positional
+// fields of the comments come after the name, followed by the key=value
+// attributes sorted by key.
+func (g *generator) docTables() []docTable {
+	tables := make([]docTable, 0, len(g.enums))
+	for _, enum := range g.enums {
+		extras := 0
+		keys := make(map[string]bool)
+		for i := range enum.values {
+			v := &enum.values[i]
+			if len(v.extras) > extras {
+				extras = len(v.extras)
+			}
+			for key := range v.meta {
+				keys[key] = true
+			}
+		}
+		var metaKeys []string
+		for key := range keys {
+			metaKeys = append(metaKeys, key)
+		}
+		sort.Strings(metaKeys)
+
+		t := docTable{
+			Name:    enum.name,
+			Heading: enum.doc,
+			Columns: []string{"Constant", "Value", "Code", "Name"},
+		}
+		if t.Heading == "" {
+			t.Heading = enum.name
+		}
+		for i := 1; i <= extras; i++ {
+			t.Columns = append(t.Columns, fmt.Sprintf("Extra %d", i))
+		}
+		t.Columns = append(t.Columns, metaKeys...)
+		for i := range enum.values {
+			v := &enum.values[i]
+			row := []string{v.originalName, v.str, v.codeName, v.cnName}
+			for j := 0; j < extras; j++ {
+				extra := ""
+				if j < len(v.extras) {
+					extra = v.extras[j]
+				}
+				row = append(row, extra)
+			}
+			for _, key := range metaKeys {
+				row = append(row, v.meta[key])
+			}
+			t.Rows = append(t.Rows, row)
+		}
+		tables = append(tables, t)
+	}
+	return tables
+}
+
+// writeDocs writes the documentation of the generated types to the named
+// file, either as Markdown or as a self-contained HTML page. Types keep the
+// -type order and values are sorted, so the output is stable across runs.
+func (g *generator) writeDocs(name, format string) {
+	tables := g.docTables()
+	b := new(bytes.Buffer)
+	switch format {
+	case DocHTML:
+		err := docHTML.Execute(b, struct {
+			Generated string
+			Package   string
+			Tables    []docTable
+		}{g.generatedComment(), g.pkg.name, tables})
+		if err != nil {
+			fail(&Error{Msg: "internal error", Err: err})
+		}
+	default:
+		fmt.Fprintf(b, "<!-- %s -->\n", g.generatedComment())
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "# %s\n", g.pkg.name)
+		for _, t := range tables {
+			fmt.Fprintf(b, "\n")
+			fmt.Fprintf(b, "## %s\n", mdEscape(t.Heading))
+			fmt.Fprintf(b, "\n")
+			fmt.Fprintf(b, "%s\n", mdRow(t.Columns))
+			align := make([]string, len(t.Columns))
+			for i := range align {
+				align[i] = "---"
+			}
+			align[1] = "---:" // Value.
+			fmt.Fprintf(b, "%s\n", mdRow(align))
+			for _, row := range t.Rows {
+				escaped := make([]string, len(row))
+				for i, cell := range row {
+					escaped[i] = mdEscape(cell)
+				}
+				fmt.Fprintf(b, "%s\n", mdRow(escaped))
+			}
+		}
+	}
+
+	g.files[name] = b.Bytes()
+}
+
+// mdRow returns the cells as a row of a Markdown table.
+func mdRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
+// mdEscaper escapes the characters with a meaning in Markdown text.
+var mdEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	"`", "\\`",
+	`*`, `\*`,
+	`<`, `&lt;`,
+	`>`, `&gt;`,
+)
+
+// mdEscape returns s escaped for a Markdown heading or table cell.
+func mdEscape(s string) string {
+	return mdEscaper.Replace(s)
+}
+
+// docHTML is the template of the HTML documentation page.
+var docHTML = template.Must(template.New("doc").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="generator" content="{{.Generated}}">
+<title>{{.Package}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f4f4f4; }
+td.value { text-align: right; }
+</style>
+</head>
+<body>
+<h1>{{.Package}}</h1>
+{{- range .Tables}}
+<h2 id="{{.Name}}">{{.Heading}}</h2>
+<table>
+<thead>
+<tr>{{range .Columns}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range $i, $cell := .}}<td{{if eq $i 1}} class="value"{{end}}>{{$cell}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
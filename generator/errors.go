@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// Error is a problem that stops the generation, such as a constant that
+// can't be handled, an invalid option or a package that fails to load.
+type Error struct {
+	Pos token.Position // The position of the problem, if known.
+	Msg string
+	Err error // The underlying error, if any.
+}
+
+func (e *Error) Error() string {
+	msg := e.Msg
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	if e.Pos.IsValid() {
+		return fmt.Sprintf("%s: %s", e.Pos, msg)
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorList is the problems found in the constants, comments and directives
+// of a package. The generation goes on after each of them so that a run
+// reports them all, in the order of their positions.
+type ErrorList []*Error
+
+func (l ErrorList) Error() string {
+	msgs := make([]string, len(l))
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Sort sorts the list by position. The problems without a position come first.
+func (l ErrorList) Sort() {
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i].Pos, l[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// bailout is the panic value that unwinds a failed generation. Generate
+// recovers it and returns its error, an *Error or an ErrorList.
+type bailout struct {
+	err error
+}
+
+// recoverError recovers a bailout into *err. Other panics go on.
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		b, ok := r.(bailout)
+		if !ok {
+			panic(r)
+		}
+		*err = b.err
+	}
+}
+
+// fail stops the generation with the error.
+func fail(err *Error) {
+	panic(bailout{err})
+}
+
+// failf stops the generation with an error without a position.
+func failf(format string, args ...interface{}) {
+	fail(&Error{Msg: fmt.Sprintf(format, args...)})
+}
+
+// errorf records a problem at the position in the package, without stopping
+// the generation. A zero position records a problem without a position.
+func (p *parsedPackage) errorf(pos token.Pos, format string, args ...interface{}) {
+	e := &Error{Msg: fmt.Sprintf(format, args...)}
+	if pos.IsValid() {
+		e.Pos = p.fset.Position(pos)
+	}
+	p.errs = append(p.errs, e)
+}
+
+// check stops the generation with the problems recorded by errorf, if any.
+func (p *parsedPackage) check() {
+	if len(p.errs) > 0 {
+		p.errs.Sort()
+		panic(bailout{p.errs})
+	}
+}
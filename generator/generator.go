@@ -0,0 +1,2190 @@
+// Package generator generates the Code and Name methods of integer enums
+// from the comments of their constants, together with the lookups, the
+// encoding methods and the exports asked for by their options. It is the
+// engine of the lxstringer command, which is a thin wrapper around Generate.
+//
+// Given
+//
+//	type Pill int
+//
+//	const (
+//		Placebo Pill = iota // placebo 安慰剂
+//		Aspirin             // aspirin 阿司匹林
+//	)
+//
+// the generated Code method returns the first field of the comment and the
+// Name method the second one.
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	defCodeIndex  = "CodeIndex"
+	defNameIndex  = "NameIndex"
+	defCodeMap    = "CodeMap"
+	defNameMap    = "NameMap"
+	defCode2IDMap = "Code2IDMap"
+	defName2IDMap = "Name2IDMap"
+	defCodeVal    = "CodeName"
+	defNameVal    = "Name"
+	defCodeFn     = "Code"
+	defNameFn     = "Name"
+	defCode2IDFn  = "CodeTo"
+	defName2IDFn  = "NameTo"
+	defIsValidFn  = "IsValid"
+	defValuesFn   = "Values"
+	defCodesFn    = "Codes"
+	defNamesFn    = "Names"
+	defOptionsFn  = "Options"
+	defBits       = "Bits"
+	defCodeGQLMap = "CodeGQLMap"
+	defGQLCodeMap = "GQLCodeMap"
+	defToPBFn     = "ToPB"
+	defFromPBFn   = "FromPB"
+	defParseCodes = "ParseCodes"
+	defParseNames = "ParseNames"
+	defMetaVal    = "Meta"
+	defMetaMap    = "MetaMap"
+	defMetaFn     = "Meta"
+	defNameInVal  = "NameIn"
+	defNameInMap  = "NameInMap"
+	defNameInFn   = "NameIn"
+	defParseFn    = "Parse"
+	defMustParse  = "MustParse"
+	lxEnumPkg     = "github.com/lixinio/lxstringer/lxenum"
+)
+
+// Orders of the ValuesOrder option.
+const (
+	OrderValue = "value"
+	OrderDecl  = "decl"
+)
+
+// Storage forms of the SQLStorage option.
+const (
+	SQLStorageInt  = "int"
+	SQLStorageCode = "code"
+)
+
+// ErrNoTypes is returned by Generate when there are no types to generate:
+// none was given and no type is annotated by a directive, or none of the
+// given types is declared by the package in SkipMissing mode.
+var ErrNoTypes = errors.New("no types to generate")
+
+// Config describes a run of Generate over a package.
+type Config struct {
+	// Patterns names the package: a directory or the files of a single
+	// package. The current directory by default.
+	Patterns []string
+	Tags     []string // The build tags, only for a directory.
+	Args     []string // The command line recorded in the generated comment.
+
+	// Types lists the types to generate. Without types, each type annotated
+	// by a directive is generated into its own <type>_string.go, or into
+	// Output if set.
+	Types []TypeOptions
+	// Options returns the options of a type annotated by a directive, which
+	// then overrides them. The defaults are used if it is nil.
+	Options func(typeName string) Options
+	// Output is the Go file of the types without one of their own. It is
+	// named after the first of them, in the package directory, by default.
+	Output string
+	// SkipMissing leaves out the types the package does not declare, for
+	// runs over several packages.
+	SkipMissing bool
+	// Declared, if not nil, is called with each of the types the package
+	// declares, so that a run over several packages can tell the types
+	// none of them declares.
+	Declared func(typeName string)
+
+	Exports Exports
+}
+
+// TypeOptions is a type to generate and its options.
+type TypeOptions struct {
+	Name   string
+	Output string // The Go file; types with the same output share it.
+	Options
+}
+
+// Exports names the files generated from all the types besides the Go code.
+// An empty name leaves the file out.
+type Exports struct {
+	Proto        string `yaml:"proto"`
+	ProtoPkg     string `yaml:"protoPkg"`
+	TS           string `yaml:"ts"`
+	Schema       string `yaml:"schema"`
+	SchemaFormat string `yaml:"schemaFormat"`
+	GraphQL      string `yaml:"graphql"`
+	Doc          string `yaml:"doc"`
+	DocFormat    string `yaml:"docFormat"`
+}
+
+// target is a generated Go file and the types it holds.
+type target struct {
+	output string
+	types  []TypeOptions
+}
+
+// Generate loads the package of the config and generates its types. It
+// returns the contents of the generated files by name, the Go files as well
+// as the exports, and writes nothing. The problems found in the package are
+// reported together as an ErrorList; the other errors are *Error values,
+// except ErrNoTypes.
+func Generate(ctx context.Context, cfg Config) (files map[string][]byte, err error) {
+	defer recoverError(&err)
+
+	patterns := cfg.Patterns
+	if len(patterns) == 0 {
+		// Default: process whole package in current directory.
+		patterns = []string{"."}
+	}
+	var dir string
+	if len(patterns) == 1 && isDirectory(patterns[0]) {
+		dir = patterns[0]
+	} else {
+		if len(cfg.Tags) != 0 {
+			failf("tags apply only to directories, not when files are specified")
+		}
+		dir = filepath.Dir(patterns[0])
+	}
+
+	g := generator{ctx: ctx, args: cfg.Args, files: make(map[string][]byte)}
+	g.parsePackage(ctx, patterns, cfg.Tags)
+	return g.generateConfig(cfg, dir)
+}
+
+// generateConfig generates the types of the config from the parsed package,
+// whose directory is dir.
+func (g *generator) generateConfig(cfg Config, dir string) (map[string][]byte, error) {
+	var types []TypeOptions
+	if len(cfg.Types) == 0 {
+		for _, d := range g.pkg.directives {
+			t := TypeOptions{Name: d.typeName}
+			if cfg.Options != nil {
+				t.Options = cfg.Options(d.typeName)
+			}
+			if cfg.Output == "" {
+				t.Output = filepath.Join(dir, strings.ToLower(fmt.Sprintf("%s_string.go", d.typeName)))
+			}
+			types = append(types, t)
+		}
+	}
+	for _, t := range cfg.Types {
+		declared := g.pkg.declares(t.Name)
+		if declared && cfg.Declared != nil {
+			cfg.Declared(t.Name)
+		}
+		if !cfg.SkipMissing || declared {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		g.pkg.check() // A type with an invalid directive is not found.
+		return nil, ErrNoTypes
+	}
+
+	// The types without an output share one, in the order of their first type.
+	var targets []target
+	index := make(map[string]int)
+	for _, t := range types {
+		output := t.Output
+		if output == "" {
+			output = cfg.Output
+		}
+		if output == "" {
+			output = filepath.Join(dir, strings.ToLower(fmt.Sprintf("%s_string.go", t.Name)))
+			cfg.Output = output
+		}
+		i, ok := index[output]
+		if !ok {
+			i = len(targets)
+			index[output] = i
+			targets = append(targets, target{output: output})
+		}
+		targets[i].types = append(targets[i].types, t)
+	}
+
+	g.run(targets, cfg.Exports)
+	return g.files, nil
+}
+
+// run generates the targets from the parsed package, then the exports of
+// all their types. The directive of a type overrides its options.
+func (g *generator) run(targets []target, exports Exports) {
+	exports.normalize()
+	for i := range targets {
+		for j := range targets[i].types {
+			t := &targets[i].types[j]
+			if d := g.pkg.directive(t.Name); d != nil {
+				t.apply(d) // Checked by addPackage.
+			}
+			if err := t.normalize(t.Name); err != nil {
+				g.pkg.errorf(g.pkg.typePos(t.Name), "%s", err)
+			}
+		}
+	}
+
+	var enums []enumType
+	for _, target := range targets {
+		tg := generator{ctx: g.ctx, pkg: g.pkg, args: g.args}
+		g.files[target.output] = tg.generateFile(target.types)
+		enums = append(enums, tg.enums...)
+	}
+
+	// The exports go on after problems in the Go code, to report their own.
+	g.enums = enums
+	if exports.Proto != "" {
+		g.writeProto(exports.Proto, exports.ProtoPkg)
+	}
+	if exports.TS != "" {
+		g.writeTypeScript(exports.TS)
+	}
+	if exports.Schema != "" {
+		g.writeSchema(exports.Schema, exports.SchemaFormat)
+	}
+	if exports.GraphQL != "" {
+		g.writeGraphQL(exports.GraphQL)
+	}
+	if exports.Doc != "" {
+		g.writeDocs(exports.Doc, exports.DocFormat)
+	}
+	g.pkg.check()
+}
+
+// normalize fills in the default formats and checks them.
+func (e *Exports) normalize() {
+	if e.SchemaFormat == "" {
+		e.SchemaFormat = SchemaJSONSchema
+	}
+	if e.SchemaFormat != SchemaJSONSchema && e.SchemaFormat != SchemaOpenAPI {
+		failf("invalid schema format %q, must be %s or %s", e.SchemaFormat, SchemaJSONSchema, SchemaOpenAPI)
+	}
+	if e.DocFormat == "" {
+		e.DocFormat = DocMarkdown
+	}
+	if e.DocFormat != DocMarkdown && e.DocFormat != DocHTML {
+		failf("invalid doc format %q, must be %s or %s", e.DocFormat, DocMarkdown, DocHTML)
+	}
+}
+
+// generateFile returns the formatted Go file holding the methods of the types.
+func (g *generator) generateFile(types []TypeOptions) []byte {
+	// Run generate for each type.
+	g.addImport("strconv") // Used by all methods.
+	for _, t := range types {
+		g.generate(t.Name, t.Options)
+		g.Printf("\n")
+	}
+
+	// Print the header, package clause and the imports collected above.
+	body := g.buf.Bytes()
+	g.buf = bytes.Buffer{}
+	g.Printf("// %s\n", g.generatedComment())
+	g.Printf("\n")
+	g.Printf("package %s", g.pkg.name)
+	g.Printf("\n")
+	g.printImports()
+	g.buf.Write(body)
+
+	if len(g.pkg.errs) > 0 {
+		return nil // Dropped, and possibly incomplete.
+	}
+	// Format the output.
+	return g.format()
+}
+
+// generatedComment returns the comment marking the outputs as generated,
+// with the command line if there is one.
+func (g *generator) generatedComment() string {
+	command := strings.Join(append([]string{"stringer"}, g.args...), " ")
+	return fmt.Sprintf("Code generated by \"%s\"; DO NOT EDIT.", command)
+}
+
+// Options holds the settings of a generated type. The zero value generates
+// the default methods.
+type Options struct {
+	CodeFnName    string   `yaml:"codeFnName"`
+	NameFnName    string   `yaml:"nameFnName"`
+	Code2IDFnName string   `yaml:"code2IDFnName"`
+	IsValidFnName string   `yaml:"isValidFnName"`
+	MetaFnName    string   `yaml:"metaFnName"`
+	NameInFnName  string   `yaml:"nameInFnName"`
+	Locale        string   `yaml:"locale"`   // The language of the names given by the name function.
+	Fallback      []string `yaml:"fallback"` // The languages tried in turn by the NameIn function.
+	GenParse      bool     `yaml:"parse"`    // Whether to generate the error-returning lookups, which depend on lxenum.
+	ParseFnName   string   `yaml:"parseFnName"`
+	MustParseName string   `yaml:"mustParseFnName"`
+	Name2IDFnName string   `yaml:"name2IDFnName"`
+	ParseNameFn   string   `yaml:"parseNameFnName"`
+	SkipCode      bool     `yaml:"skipCode"`
+	GenJSON       bool     `yaml:"json"`
+	GenText       bool     `yaml:"text"`
+	SQLStorage    string   `yaml:"sql"` // The storage form of the Scan/Value methods, or "" for none.
+	GenNull       bool     `yaml:"null"`
+	ValuesOrder   string   `yaml:"values"`
+	GenOptions    bool     `yaml:"options"`
+	Bitmask       bool     `yaml:"bitmask"`
+	Separator     string   `yaml:"sep"`
+	PBTarget      string   `yaml:"pb"` // The protobuf enum to convert to, or "" for none.
+	PBMatch       string   `yaml:"pbMatch"`
+	GenGQL        bool     `yaml:"gqlgen"`
+}
+
+// normalize fills in the default names of the options of the named type
+// and checks the others.
+func (o *Options) normalize(typeName string) error {
+	if o.CodeFnName == "" {
+		o.CodeFnName = defCodeFn
+	}
+	if o.NameFnName == "" {
+		o.NameFnName = defNameFn
+	}
+	if o.IsValidFnName == "" {
+		o.IsValidFnName = defIsValidFn
+	}
+	if o.MetaFnName == "" {
+		o.MetaFnName = defMetaFn
+	}
+	if o.NameInFnName == "" {
+		o.NameInFnName = defNameInFn
+	}
+	if o.Separator == "" {
+		o.Separator = "|"
+	}
+	if o.PBMatch == "" {
+		o.PBMatch = PBMatchCode
+	}
+	if o.SQLStorage != "" && o.SQLStorage != SQLStorageInt && o.SQLStorage != SQLStorageCode {
+		return fmt.Errorf("invalid sql storage %q for type %s, must be %s or %s", o.SQLStorage, typeName, SQLStorageInt, SQLStorageCode)
+	}
+	if o.PBMatch != PBMatchCode && o.PBMatch != PBMatchName {
+		return fmt.Errorf("invalid pb match %q for type %s, must be %s or %s", o.PBMatch, typeName, PBMatchCode, PBMatchName)
+	}
+	if o.ValuesOrder != "" && o.ValuesOrder != OrderValue && o.ValuesOrder != OrderDecl {
+		return fmt.Errorf("invalid values order %q for type %s, must be %s or %s", o.ValuesOrder, typeName, OrderValue, OrderDecl)
+	}
+	if o.IsValidFnName == "-" && (o.SQLStorage != "" || o.GenNull) {
+		return fmt.Errorf("can't skip the is valid function of type %s, the sql methods need it", typeName)
+	}
+	if o.IsValidFnName == "-" && (o.GenJSON || o.GenText) {
+		return fmt.Errorf("can't skip the is valid function of type %s, the json and text methods need it", typeName)
+	}
+	return nil
+}
+
+// isDirectory reports whether the named file is a directory.
+func isDirectory(name string) bool {
+	info, err := os.Stat(name)
+	if err != nil {
+		failf("%s", err)
+	}
+	return info.IsDir()
+}
+
+// generator holds the state of the analysis. Primarily used to buffer
+// the output for format.Source.
+type generator struct {
+	ctx   context.Context
+	buf   bytes.Buffer      // Accumulated output.
+	pkg   *parsedPackage    // Package we are scanning.
+	args  []string          // The command line recorded in the generated comment.
+	files map[string][]byte // The generated files, by name.
+
+	Options // Options of the type being generated.
+
+	imports map[string]string // Packages used by the generated code, with their names.
+	enums   []enumType        // Types generated so far, for the exporters.
+}
+
+// enumType holds the values of a generated type.
+type enumType struct {
+	name   string
+	doc    string      // The doc comment of the type declaration.
+	values []enumValue // Sorted by value, without duplicates.
+	byCode bool        // Whether JSON encodes the values as their codes, by -json or -text.
+}
+
+// codeValues returns the values without the aliases sharing the code of
+// another value, for the exports keyed by code. As with the lookups, the
+// code stands for the value that is not marked @alias.
+func (e *enumType) codeValues() []enumValue {
+	primary := primaryKeys([][]enumValue{e.values}, valueCode)
+	values := make([]enumValue, 0, len(e.values))
+	for _, v := range e.values {
+		if !v.isAlias(primary, valueCode) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func (g *generator) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// addImport records a package the generated code depends on.
+func (g *generator) addImport(path string) {
+	g.addNamedImport("", path)
+}
+
+// addNamedImport records a package the generated code refers to by name,
+// which is empty if it is the last element of the path.
+func (g *generator) addNamedImport(name, path string) {
+	if g.imports == nil {
+		g.imports = make(map[string]string)
+	}
+	g.imports[path] = name
+}
+
+// printImports prints the import clause for the recorded packages,
+// the standard library ones first.
+func (g *generator) printImports() {
+	var std, other []string
+	for path := range g.imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	spec := func(path string) string {
+		if name := g.imports[path]; name != "" {
+			return fmt.Sprintf("%s %q", name, path)
+		}
+		return fmt.Sprintf("%q", path)
+	}
+	if len(std)+len(other) == 1 {
+		g.Printf("import %s\n", spec(append(std, other...)[0]))
+		return
+	}
+	g.Printf("import (\n")
+	for _, path := range std {
+		g.Printf("\t%s\n", spec(path))
+	}
+	if len(std) > 0 && len(other) > 0 {
+		g.Printf("\n")
+	}
+	for _, path := range other {
+		g.Printf("\t%s\n", spec(path))
+	}
+	g.Printf(")\n")
+}
+
+// parsedFile holds a single parsed file and associated data.
+type parsedFile struct {
+	pkg  *parsedPackage // Package to which this file belongs.
+	file *ast.File      // Parsed AST.
+	// These fields are reset for each type being generated.
+	typeName string      // Name of the constant type.
+	values   []enumValue // Accumulator for constant values of that type.
+	skipCode bool
+	locale   string
+}
+
+type parsedPackage struct {
+	name       string
+	fset       *token.FileSet
+	defs       map[*ast.Ident]types.Object
+	files      []*parsedFile
+	directives []*directive // The annotated types, in declaration order.
+	errs       ErrorList    // The problems found so far.
+}
+
+// declares reports whether the package declares the named type.
+func (p *parsedPackage) declares(typeName string) bool {
+	return p.typePos(typeName).IsValid()
+}
+
+// typePos returns the position of the declaration of the named type, or
+// token.NoPos if the package does not declare it.
+func (p *parsedPackage) typePos(typeName string) token.Pos {
+	pos := token.NoPos
+	for _, file := range p.files {
+		file.typeSpecs(func(tspec *ast.TypeSpec, doc *ast.CommentGroup) {
+			if tspec.Name.Name == typeName && !pos.IsValid() {
+				pos = tspec.Name.Pos()
+			}
+		})
+	}
+	return pos
+}
+
+// directive returns the directive of the named type, or nil if it has none.
+func (p *parsedPackage) directive(typeName string) *directive {
+	for _, d := range p.directives {
+		if d.typeName == typeName {
+			return d
+		}
+	}
+	return nil
+}
+
+// parsePackage analyzes the single package constructed from the patterns and tags.
+// parsePackage fails if there is an error.
+func (g *generator) parsePackage(ctx context.Context, patterns []string, tags []string) {
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    packages.LoadSyntax,
+		// TODO: Need to think about constants in test files. Maybe write type_string_test.go
+		// in a separate pass? For later.
+		Tests:      false,
+		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(tags, " "))},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		fail(&Error{Msg: "loading packages", Err: err})
+	}
+	if len(pkgs) != 1 {
+		failf("%d packages found", len(pkgs))
+	}
+	g.addPackage(pkgs[0])
+}
+
+// addPackage adds a type checked Package and its syntax files to the generator.
+func (g *generator) addPackage(pkg *packages.Package) {
+	g.pkg = &parsedPackage{
+		name:  pkg.Name,
+		fset:  pkg.Fset,
+		defs:  pkg.TypesInfo.Defs,
+		files: make([]*parsedFile, len(pkg.Syntax)),
+	}
+
+	for i, file := range pkg.Syntax {
+		g.pkg.files[i] = &parsedFile{
+			file: file,
+			pkg:  g.pkg,
+		}
+		g.pkg.files[i].typeSpecs(func(tspec *ast.TypeSpec, doc *ast.CommentGroup) {
+			d, pos, err := parseDirective(tspec.Name.Name, doc)
+			if err != nil {
+				g.pkg.errorf(pos, "%s", err)
+			}
+			if d != nil {
+				g.pkg.directives = append(g.pkg.directives, d)
+			}
+		})
+	}
+}
+
+// generate produces the String method for the named type.
+func (g *generator) generate(typeName string, opts Options) {
+	g.Options = opts
+	values := make([]enumValue, 0, 100)
+	errs := len(g.pkg.errs)
+	for _, file := range g.pkg.files {
+		// Set the state for this run of the walker.
+		file.typeName = typeName
+		file.values = nil
+		file.skipCode = g.SkipCode
+		file.locale = g.Locale
+		if file.file != nil {
+			ast.Inspect(file.file, file.genDecl)
+			values = append(values, file.values...)
+		}
+	}
+
+	if len(values) == 0 && len(g.pkg.errs) == errs {
+		g.pkg.errorf(g.pkg.typePos(typeName), "no values defined for type %s", typeName)
+	}
+	g.checkDuplicates(values)
+	if g.GenOptions {
+		g.checkOptions(values)
+	}
+	if len(g.pkg.errs) > errs {
+		// The output will be dropped; go on only to find the problems of the other types.
+		return
+	}
+	// Generate code that will fail if the constants change value.
+	g.Printf("func _() {\n")
+	g.Printf("\t// An \"invalid array index\" compiler error signifies that the constant values have changed.\n")
+	g.Printf("\t// Re-run the stringer command to generate them again.\n")
+	g.Printf("\tvar x [1]struct{}\n")
+	for _, v := range values {
+		g.Printf("\t_ = x[%s - %s]\n", v.originalName, v.str)
+	}
+	g.Printf("}\n")
+	// splitIntoRuns sorts the values in place, so keep the declaration order.
+	declared := append([]enumValue(nil), values...)
+	runs := splitIntoRuns(values)
+	enum := enumType{name: typeName, doc: g.typeDoc(typeName), byCode: g.GenJSON || g.GenText}
+	for _, run := range runs {
+		enum.values = append(enum.values, run...)
+	}
+	g.enums = append(g.enums, enum)
+	// The decision of which pattern to use depends on the number of
+	// runs in the numbers. If there's only one, it's easy. For more than
+	// one, there's a tradeoff between complexity and size of the data
+	// and code vs. the simplicity of a map. A map takes more space,
+	// but so does the code. The decision here (crossover at 10) is
+	// arbitrary, but considers that for large numbers of runs the cost
+	// of the linear scan in the switch might become important, and
+	// rather than use yet another algorithm such as binary search,
+	// we punt and use a map. In any case, the likelihood of a map
+	// being necessary for any realistic example other than bitmasks
+	// is very low. Bitmasks get their own analysis, selected by -bitmask.
+	switch {
+	case g.Bitmask:
+		g.buildBitmask(runs, typeName)
+		g.code2ID(runs, typeName)
+		g.name2ID(runs, typeName, false)
+	case len(runs) == 1:
+		g.buildOneRun(runs, typeName)
+		g.code2ID(runs, typeName)
+		g.name2ID(runs, typeName, false)
+	case len(runs) <= 10:
+		g.buildMultipleRuns(runs, typeName)
+		g.code2ID2(runs, typeName)
+		g.name2ID(runs, typeName, true)
+	default:
+		g.buildMap(runs, typeName)
+		g.code2ID(runs, typeName)
+		g.name2ID(runs, typeName, false)
+	}
+	if g.IsValidFnName != "-" {
+		g.buildIsValid(runs, typeName)
+	}
+	if g.GenJSON {
+		g.buildJSON(typeName)
+	}
+	if g.GenText {
+		g.buildText(typeName)
+	}
+	if storage := g.sqlStorageOf(); storage != "" {
+		g.buildSQL(typeName, storage, values[0].signed)
+	}
+	if g.GenNull {
+		g.buildNull(typeName)
+	}
+	switch g.ValuesOrder {
+	case OrderValue:
+		var sorted []enumValue
+		for _, run := range runs {
+			sorted = append(sorted, run...)
+		}
+		g.buildValues(sorted, typeName)
+	case OrderDecl:
+		g.buildValues(uniqueValues(declared), typeName)
+	}
+	if g.GenOptions {
+		g.buildOptions(runs, typeName)
+	}
+	if g.MetaFnName != "-" {
+		g.buildMeta(runs, typeName)
+	}
+	if g.NameInFnName != "-" {
+		g.buildNameIn(runs, typeName)
+	}
+	if g.GenGQL {
+		g.buildGQL(&enum)
+	}
+	if g.PBTarget != "" {
+		g.buildPB(enum.values, typeName, loadPBEnum(g.ctx, g.PBTarget), g.PBMatch)
+	}
+}
+
+// uniqueValues drops the values that repeat an earlier one, keeping the
+// first declared name like splitIntoRuns does.
+func uniqueValues(values []enumValue) []enumValue {
+	seen := make(map[uint64]bool)
+	unique := make([]enumValue, 0, len(values))
+	for _, v := range values {
+		if seen[v.value] {
+			continue
+		}
+		seen[v.value] = true
+		unique = append(unique, v)
+	}
+	return unique
+}
+
+// checkDuplicates reports the values sharing their code with another value,
+// and their name if names are looked up, unless marked @alias. The lookup
+// maps are keyed by slices of the packed strings, so the compiler would not
+// catch the duplicate keys and one of the values would silently win. For the
+// same reason, the values sharing a string can't all be aliases: one of them
+// must be left unmarked for the lookups to return.
+//
+// Display names are often shared, so the name lookups of such a type are
+// skipped unless one of them is given a name, rather than failing.
+func (g *generator) checkDuplicates(values []enumValue) {
+	// check reports the duplicates of the strings given by fn.
+	check := func(kind string, fn func(*enumValue) string, hint string) {
+		primary := make(map[string]bool)
+		for i := range values {
+			if !values[i].annotations[AnnotationAlias] {
+				primary[fn(&values[i])] = true
+			}
+		}
+		seen := make(map[string]*enumValue)
+		for i := range values {
+			v := &values[i]
+			if v.isAlias(primary, fn) {
+				continue
+			}
+			prev, ok := seen[fn(v)]
+			if !ok {
+				seen[fn(v)] = v
+				continue
+			}
+			if prev.value == v.value {
+				continue // The same value under another name.
+			}
+			pos := g.pkg.fset.Position(prev.pos)
+			if v.annotations[AnnotationAlias] {
+				g.pkg.errorf(
+					v.pos, "constant %s has the same %s %q as %s at %s:%d:%d and both are marked @%s, leave the one to look up unmarked",
+					v.originalName, kind, fn(v), prev.originalName,
+					filepath.Base(pos.Filename), pos.Line, pos.Column, AnnotationAlias,
+				)
+				continue
+			}
+			g.pkg.errorf(
+				v.pos, "constant %s has the same %s %q as %s at %s:%d:%d, mark it @%s if intended%s",
+				v.originalName, kind, fn(v), prev.originalName,
+				filepath.Base(pos.Filename), pos.Line, pos.Column, AnnotationAlias, hint,
+			)
+		}
+	}
+	check("code", valueCode, "")
+	if g.SkipCode || !g.nameLookups() {
+		return
+	}
+	check("name", valueName, " or use -nametoid=- -parsename=- to skip name lookups")
+}
+
+// checkOptions reports the values of an unsigned type too large for the int64
+// Value of the Options list, as the conversion would not compile.
+func (g *generator) checkOptions(values []enumValue) {
+	for i := range values {
+		v := &values[i]
+		if !v.signed && v.value > math.MaxInt64 && !v.annotations[AnnotationHidden] {
+			g.pkg.errorf(
+				v.pos, "value %s of constant %s overflows the int64 Value of -options, mark it @%s to leave it out",
+				v.str, v.originalName, AnnotationHidden,
+			)
+		}
+	}
+}
+
+// primaryKeys returns the strings given by fn of the values not marked @alias.
+func primaryKeys(runs [][]enumValue, fn func(*enumValue) string) map[string]bool {
+	keys := make(map[string]bool)
+	for _, values := range runs {
+		for i := range values {
+			if !values[i].annotations[AnnotationAlias] {
+				keys[fn(&values[i])] = true
+			}
+		}
+	}
+	return keys
+}
+
+// isAlias reports whether the value is marked @alias and shares the string
+// given by fn with a value that is not, which then takes its place.
+func (v *enumValue) isAlias(primary map[string]bool, fn func(*enumValue) string) bool {
+	return v.annotations[AnnotationAlias] && primary[fn(v)]
+}
+
+// splitIntoRuns breaks the values into runs of contiguous sequences.
+// For example, given 1,2,3,5,6,7 it returns {1,2,3},{5,6,7}.
+// The input slice is known to be non-empty.
+func splitIntoRuns(values []enumValue) [][]enumValue {
+	// We use stable sort so the lexically first name is chosen for equal elements.
+	sort.Stable(byValue(values))
+	// Remove duplicates. Stable sort has put the one we want to print first,
+	// so use that one. The String method won't care about which named constant
+	// was the argument, so the first name for the given value is the only one to keep.
+	// We need to do this because identical values would cause the switch or map
+	// to fail to compile.
+	j := 1
+	for i := 1; i < len(values); i++ {
+		if values[i].value != values[i-1].value {
+			values[j] = values[i]
+			j++
+		}
+	}
+	values = values[:j]
+	runs := make([][]enumValue, 0, 10)
+	for len(values) > 0 {
+		// One contiguous sequence per outer loop.
+		i := 1
+		for i < len(values) && values[i].value == values[i-1].value+1 {
+			i++
+		}
+		runs = append(runs, values[:i])
+		values = values[i:]
+	}
+	return runs
+}
+
+// format returns the gofmt-ed contents of the generator's buffer.
+func (g *generator) format() []byte {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		// Should never happen, but can arise when developing this code.
+		fail(&Error{Msg: "internal error: invalid Go generated", Err: err})
+	}
+	return src
+}
+
+// enumValue represents a declared constant.
+type enumValue struct {
+	originalName string // The name of the constant.
+	codeName     string // The name with trimmed prefix.
+	cnName       string
+	annotations  map[string]bool   // The @annotations of the comment, without the "@".
+	meta         map[string]string // The key=value attributes of the comment.
+	locales      map[string]string // The lang:"name" names of the comment, by language.
+	extras       []string          // The fields of the comment after the code and name.
+	pos          token.Pos         // The position of the constant.
+	// The value is stored as a bit pattern alone. The boolean tells us
+	// whether to interpret it as an int64 or a uint64; the only place
+	// this matters is when sorting.
+	// Much of the time the str field is all we need; it is printed
+	// by enumValue.String.
+	value  uint64 // Will be converted to int64 when needed.
+	signed bool   // Whether the constant is a signed type.
+	str    string // The string representation given by the "go/constant" package.
+}
+
+func (v *enumValue) String() string {
+	return v.str
+}
+
+func valueCode(v *enumValue) string {
+	return v.codeName
+}
+
+func valueName(v *enumValue) string {
+	return v.cnName
+}
+
+// Annotations understood in constant comments.
+const (
+	AnnotationHidden = "hidden" // Leave the value out of the Options list.
+	AnnotationAlias  = "alias"  // Let the value share the code or name of another one, which the lookups keep.
+)
+
+// knownAnnotations are the annotations understood in constant comments.
+var knownAnnotations = map[string]bool{
+	AnnotationHidden: true,
+	AnnotationAlias:  true,
+}
+
+// byValue lets us sort the constants into increasing order.
+// We take care in the Less method to sort in signed or unsigned order,
+// as appropriate.
+type byValue []enumValue
+
+func (b byValue) Len() int      { return len(b) }
+func (b byValue) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byValue) Less(i, j int) bool {
+	if b[i].signed {
+		return int64(b[i].value) < int64(b[j].value)
+	}
+	return b[i].value < b[j].value
+}
+
+// genDecl processes one declaration clause.
+func (f *parsedFile) genDecl(node ast.Node) bool {
+	decl, ok := node.(*ast.GenDecl)
+	if !ok || decl.Tok != token.CONST {
+		// We only care about const declarations.
+		return true
+	}
+	// The name of the type of the constants we are declaring.
+	// Can change if this is a multi-element declaration.
+	typ := ""
+	// Loop over the elements of the declaration. Each element is a ValueSpec:
+	// a list of names possibly followed by a type, possibly followed by values.
+	// If the type and value are both missing, we carry down the type (and value,
+	// but the "go/types" package takes care of that).
+	for _, spec := range decl.Specs {
+		vspec := spec.(*ast.ValueSpec) // Guaranteed to succeed as this is CONST.
+		if vspec.Type == nil && len(vspec.Values) > 0 {
+			// "X = 1". With no type but a value. If the constant is untyped,
+			// skip this vspec and reset the remembered type.
+			typ = ""
+
+			// If this is a simple type conversion, remember the type.
+			// We don't mind if this is actually a call; a qualified call won't
+			// be matched (that will be SelectorExpr, not Ident), and only unusual
+			// situations will result in a function call that appears to be
+			// a type conversion.
+			ce, ok := vspec.Values[0].(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			id, ok := ce.Fun.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			typ = id.Name
+		}
+		if vspec.Type != nil {
+			// "X T". We have a type. Remember it.
+			ident, ok := vspec.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			typ = ident.Name
+		}
+		if typ != f.typeName {
+			// This is not the type we're looking for.
+			continue
+		}
+		// We now have a list of names (from one line of source code) all being
+		// declared with the desired type.
+		// Grab their names and actual values and store them in f.values.
+		for _, name := range vspec.Names {
+			if name.Name == "_" {
+				continue
+			}
+			// This dance lets the type checker find the values for us. It's a
+			// bit tricky: look up the object declared by the name, find its
+			// types.Const, and extract its value.
+			obj, ok := f.pkg.defs[name]
+			if !ok {
+				f.pkg.errorf(name.Pos(), "no value for constant %s", name)
+				continue
+			}
+			info := obj.Type().Underlying().(*types.Basic).Info()
+			if info&types.IsInteger == 0 {
+				f.pkg.errorf(name.Pos(), "can't handle non-integer constant type %s", typ)
+				continue
+			}
+			value := obj.(*types.Const).Val() // Guaranteed to succeed as this is CONST.
+			if value.Kind() != constant.Int {
+				f.pkg.errorf(name.Pos(), "can't happen: constant is not an integer %s", name)
+				continue
+			}
+			i64, isInt := constant.Int64Val(value)
+			u64, isUint := constant.Uint64Val(value)
+			if !isInt && !isUint {
+				f.pkg.errorf(name.Pos(), "internal error: value of %s is not an integer: %s", name, value.String())
+				continue
+			}
+			if !isInt {
+				u64 = uint64(i64)
+			}
+			v := enumValue{
+				originalName: name.Name,
+				pos:          name.Pos(),
+				value:        u64,
+				signed:       info&types.IsUnsigned == 0,
+				str:          value.String(),
+			}
+			if c := vspec.Comment; c != nil && len(c.List) == 1 {
+				comment := ParseComment(c.Text(), f.skipCode)
+				v.codeName, v.cnName = comment.Code, comment.Name
+				v.meta, v.locales, v.extras = comment.Meta, comment.Locales, comment.Extras
+				for _, a := range comment.Annotations {
+					if !knownAnnotations[a] {
+						f.pkg.errorf(c.Pos(), "unknown annotation @%s for constant %s", a, name)
+						continue
+					}
+					if v.annotations == nil {
+						v.annotations = make(map[string]bool)
+					}
+					v.annotations[a] = true
+				}
+			}
+			if v.cnName == "" && f.locale != "" {
+				v.cnName = v.locales[f.locale]
+			}
+			if v.cnName == "" {
+				v.cnName = v.originalName
+			}
+			if v.codeName == "" {
+				v.codeName = v.originalName
+			}
+			f.values = append(f.values, v)
+		}
+	}
+	return false
+}
+
+// Comment is the line comment of a constant, as in
+//
+//	FrozenStatusFreezing // freezing 冻结中 en:"Frozen" color=blue @hidden
+type Comment struct {
+	Code        string            // The first field, or "" if there is none.
+	Name        string            // The second field, or the code if the code is skipped.
+	Annotations []string          // The @annotations after the code and name, without the "@".
+	Meta        map[string]string // The key=value attributes after the code and name.
+	Locales     map[string]string // The lang:"name" names, by language.
+	Extras      []string          // The other fields after the code and name.
+}
+
+// commentRe matches the fields of a comment, which may be quoted as in "code example".
+var commentRe = regexp.MustCompile(`[^\s"]*"[^"]*"|[^\s"]+`)
+
+// ParseComment parses the text of the line comment of a constant. If skipCode
+// is set, the first field is both the code and the name. The annotations are
+// returned as written, known or not; the known ones are never taken for the
+// code or name.
+func ParseComment(text string, skipCode bool) Comment {
+	var c Comment
+	fields, locales := splitLocales(commentRe.FindAllString(strings.TrimSpace(text), -1))
+	c.Locales = locales
+	head := 2 // The code and name.
+	if skipCode {
+		head = 1
+	}
+	names, rest := splitHead(fields, head)
+	rest, c.Annotations = splitAnnotations(rest)
+	rest, c.Meta = splitMeta(rest)
+	if len(names) > 0 {
+		c.Code = strings.Trim(names[0], "\"")
+		if skipCode {
+			c.Name = c.Code
+		}
+	}
+	if !skipCode && len(names) > 1 {
+		c.Name = strings.Trim(names[1], "\"")
+	}
+	for _, field := range rest {
+		c.Extras = append(c.Extras, strings.Trim(field, "\""))
+	}
+	return c
+}
+
+// splitAnnotations separates the @annotations from the fields of a comment
+// after its code and name.
+func splitAnnotations(fields []string) ([]string, []string) {
+	var rest, annotations []string
+	for _, field := range fields {
+		if len(field) > 1 && field[0] == '@' {
+			annotations = append(annotations, field[1:])
+			continue
+		}
+		rest = append(rest, field)
+	}
+	return rest, annotations
+}
+
+// splitHead separates the first n fields of a comment, its code and name,
+// from the fields after them. Known annotations are never taken for a code
+// or name, so that "// unknown @hidden" has no name while "// @admin 管理员"
+// keeps its code.
+func splitHead(fields []string, n int) (head, rest []string) {
+	for _, field := range fields {
+		if len(head) < n && !(len(field) > 1 && field[0] == '@' && knownAnnotations[field[1:]]) {
+			head = append(head, field)
+			continue
+		}
+		rest = append(rest, field)
+	}
+	return head, rest
+}
+
+// metaRe matches the key=value attributes of a comment.
+var metaRe = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)=(.*)$`)
+
+// splitMeta separates the key=value attributes from the fields of a comment
+// after its code and name. The value may be quoted, as in desc="some text".
+func splitMeta(fields []string) ([]string, map[string]string) {
+	var rest []string
+	var meta map[string]string
+	for _, field := range fields {
+		m := metaRe.FindStringSubmatch(field)
+		if m == nil {
+			rest = append(rest, field)
+			continue
+		}
+		if meta == nil {
+			meta = make(map[string]string)
+		}
+		meta[m[1]] = strings.Trim(m[2], "\"")
+	}
+	return rest, meta
+}
+
+// localeRe matches the lang:"name" names of a comment. The name must be
+// quoted, so that codes such as vip:gold are left alone.
+var localeRe = regexp.MustCompile(`^([a-z]{2,3}(?:[-_][A-Za-z]{2,4})?):"(.*)"$`)
+
+// splitLocales separates the lang:"name" names from the other fields of a comment.
+func splitLocales(fields []string) ([]string, map[string]string) {
+	var rest []string
+	var locales map[string]string
+	for _, field := range fields {
+		m := localeRe.FindStringSubmatch(field)
+		if m == nil {
+			rest = append(rest, field)
+			continue
+		}
+		if locales == nil {
+			locales = make(map[string]string)
+		}
+		locales[m[1]] = m[2]
+	}
+	return rest, locales
+}
+
+// Helpers
+
+// usize returns the number of bits of the smallest unsigned integer
+// type that will hold n. Used to create the smallest possible slice of
+// integers to use as indexes into the concatenated strings.
+func usize(n int) int {
+	switch {
+	case n < 1<<8:
+		return 8
+	case n < 1<<16:
+		return 16
+	default:
+		// 2^32 is enough constants for anyone.
+		return 32
+	}
+}
+
+// declareIndexAndNameVars declares the index slices and concatenated names
+// strings representing the runs of values.
+func (g *generator) declareIndexAndNameVars(runs [][]enumValue, typeName string) {
+	var indexes, names []string
+	for i, run := range runs {
+		indexs, namex := g.createIndexAndNameDecl(run, typeName, fmt.Sprintf("_%d", i))
+		if len(run) != 1 {
+			indexes = append(indexes, indexs[0], indexs[1])
+		}
+		names = append(names, namex[0], namex[1])
+	}
+	g.Printf("const (\n")
+	for _, name := range names {
+		g.Printf("\t%s\n", name)
+	}
+	g.Printf(")\n\n")
+
+	if len(indexes) > 0 {
+		g.Printf("var (")
+		for _, index := range indexes {
+			g.Printf("\t%s\n", index)
+		}
+		g.Printf(")\n\n")
+	}
+}
+
+// declareIndexAndNameVar is the single-run version of declareIndexAndNameVars
+func (g *generator) declareIndexAndNameVar(run []enumValue, typeName string) {
+	indexs, names := g.createIndexAndNameDecl(run, typeName, "")
+	g.Printf("const (\n")
+	g.Printf("%s\n", names[0])
+	g.Printf("%s\n", names[1])
+	g.Printf(")\n\n")
+
+	g.Printf("var (\n")
+	g.Printf("%s\n", indexs[0])
+	g.Printf("%s\n", indexs[1])
+	g.Printf(")\n\n")
+}
+
+// createIndexAndNameDecl returns the pair of declarations for the run. The caller will add "const" and "var".
+func (g *generator) createIndexAndNameDecl(
+	run []enumValue,
+	typeName string,
+	suffix string,
+) ([2]string, [2]string) {
+	f := func(nameKey, indexKey string, fn func(*enumValue) string) (string, string) {
+		b := new(bytes.Buffer)
+		indexes := make([]int, len(run))
+		for i := range run {
+			b.WriteString(fn(&run[i]))
+			indexes[i] = b.Len()
+		}
+		nameConst := fmt.Sprintf("_%s%s%s = %q", typeName, nameKey, suffix, b.String())
+		nameLen := b.Len()
+
+		b.Reset()
+		fmt.Fprintf(b, "_%s%s%s = [...]uint%d{0, ", typeName, indexKey, suffix, usize(nameLen))
+		for i, v := range indexes {
+			if i > 0 {
+				fmt.Fprintf(b, ", ")
+			}
+			fmt.Fprintf(b, "%d", v)
+		}
+		fmt.Fprintf(b, "}")
+		return b.String(), nameConst
+	}
+
+	idx1, name1 := f(defCodeVal, defCodeIndex, valueCode)
+	idx2, name2 := f(defNameVal, defNameIndex, valueName)
+	return [2]string{idx1, idx2}, [2]string{name1, name2}
+}
+
+// declareNameVars declares the concatenated names string representing all the values in the runs.
+func (g *generator) declareNameVars(runs [][]enumValue, typeName string, suffix string) {
+	g.Printf("const (\n")
+	f := func(nameKey string, fn func(*enumValue) string) {
+		g.Printf("\t_%s%s%s = \"", typeName, nameKey, suffix)
+		for _, run := range runs {
+			for i := range run {
+				g.Printf("%s", fn(&run[i]))
+			}
+		}
+		g.Printf("\"\n")
+	}
+	f(defCodeVal, valueCode)
+	f(defNameVal, valueName)
+	g.Printf(")\n")
+}
+
+// buildOneRun generates the variables and String method for a single run of contiguous values.
+func (g *generator) buildOneRun(runs [][]enumValue, typeName string) {
+	values := runs[0]
+	g.Printf("\n")
+	g.declareIndexAndNameVar(values, typeName)
+	// The generated code is simple enough to write as a Printf format.
+	lessThanZero := ""
+	if values[0].signed {
+		lessThanZero = "i < 0 || "
+	}
+	if values[0].value == 0 { // Signed or unsigned, 0 is still 0.
+		g.Printf(
+			stringOneRun, typeName, usize(len(values)), lessThanZero,
+			g.CodeFnName, defCodeVal, defCodeIndex,
+		)
+		g.Printf("\n")
+		g.Printf(
+			stringOneRun, typeName, usize(len(values)), lessThanZero,
+			g.NameFnName, defNameVal, defNameIndex,
+		)
+	} else {
+		g.Printf(
+			stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)),
+			lessThanZero, g.CodeFnName, defCodeVal, defCodeIndex,
+		)
+		g.Printf("\n")
+		g.Printf(
+			stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)),
+			lessThanZero, g.NameFnName, defNameVal, defNameIndex,
+		)
+	}
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: size of index element (8 for uint8 etc.)
+//	[3]: less than zero check (for signed types)
+const stringOneRun = `func (i %[1]s) %[4]s() string {
+	if %[3]si >= %[1]s(len(_%[1]s%[6]s)-1) {
+		return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _%[1]s%[5]s[_%[1]s%[6]s[i]:_%[1]s%[6]s[i+1]]
+}
+`
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: lowest defined value for type, as a string
+//	[3]: size of index element (8 for uint8 etc.)
+//	[4]: less than zero check (for signed types)
+/*
+ */
+const stringOneRunWithOffset = `func (i %[1]s) %[5]s() string {
+	i -= %[2]s
+	if %[4]si >= %[1]s(len(_%[1]s%[7]s)-1) {
+		return "%[1]s(" + strconv.FormatInt(int64(i + %[2]s), 10) + ")"
+	}
+	return _%[1]s%[6]s[_%[1]s%[7]s[i] : _%[1]s%[7]s[i+1]]
+}
+`
+
+// buildMultipleRuns generates the variables and String method for multiple runs of contiguous values.
+// For this pattern, a single Printf format won't do.
+func (g *generator) buildMultipleRuns(runs [][]enumValue, typeName string) {
+	g.Printf("\n")
+	g.declareIndexAndNameVars(runs, typeName)
+
+	f := func(funcName, nameKey, indexKey string) {
+		g.Printf("func (i %s) %s() string {\n", typeName, funcName)
+		g.Printf("\tswitch {\n")
+		for i, values := range runs {
+			if len(values) == 1 {
+				g.Printf("\tcase i == %s:\n", &values[0])
+				g.Printf("\t\treturn _%s%s_%d\n", typeName, nameKey, i)
+				continue
+			}
+			if values[0].value == 0 && !values[0].signed {
+				// For an unsigned lower bound of 0, "0 <= i" would be redundant.
+				g.Printf("\tcase i <= %s:\n", &values[len(values)-1])
+			} else {
+				g.Printf("\tcase %s <= i && i <= %s:\n", &values[0], &values[len(values)-1])
+			}
+			if values[0].value != 0 {
+				g.Printf("\t\ti -= %s\n", &values[0])
+			}
+			g.Printf(
+				"\t\treturn _%s%s_%d[_%s%s_%d[i]:_%s%s_%d[i+1]]\n",
+				typeName, nameKey, i, typeName, indexKey, i, typeName, indexKey, i,
+			)
+		}
+		g.Printf("\tdefault:\n")
+		g.Printf("\t\treturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n", typeName)
+		g.Printf("\t}\n")
+		g.Printf("}\n")
+	}
+	f(g.CodeFnName, defCodeVal, defCodeIndex)
+	g.Printf("\n")
+	f(g.NameFnName, defNameVal, defNameIndex)
+}
+
+// buildMap handles the case where the space is so sparse a map is a reasonable fallback.
+// It's a rare situation but has simple code.
+func (g *generator) buildMap(runs [][]enumValue, typeName string) {
+	g.Printf("\n")
+	g.declareMapVars(runs, typeName)
+	g.Printf(stringMap, typeName, g.CodeFnName, defCodeMap)
+	g.Printf("\n")
+	g.Printf(stringMap, typeName, g.NameFnName, defNameMap)
+}
+
+// declareMapVars declares the concatenated names strings and the maps from
+// the values to their slices.
+func (g *generator) declareMapVars(runs [][]enumValue, typeName string) {
+	g.declareNameVars(runs, typeName, "")
+	f := func(mapName, nameKey string, fn func(*enumValue) string) {
+		g.Printf("\nvar _%s%s = map[%s]string{\n", typeName, mapName, typeName)
+		n := 0
+		for _, values := range runs {
+			for _, value := range values {
+				g.Printf("\t%s: _%s%s[%d:%d],\n", &value, typeName, nameKey, n, n+len(fn(&value)))
+				n += len(fn(&value))
+			}
+		}
+		g.Printf("}\n\n")
+	}
+	f(defCodeMap, defCodeVal, valueCode)
+	f(defNameMap, defNameVal, valueName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: map key
+const stringMap = `func (i %[1]s) %[2]s() string {
+	if str, ok := _%[1]s%[3]s[i]; ok {
+		return str
+	}
+	return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+`
+
+// buildBitmask generates the variables and String methods of a bitmask type.
+// A value that isn't declared is decomposed into its bits, whose strings
+// are joined with the separator.
+func (g *generator) buildBitmask(runs [][]enumValue, typeName string) {
+	g.addImport("strings")
+	g.Printf("\n")
+	g.declareMapVars(runs, typeName)
+	g.Printf("var _%s%s = [...]%s{", typeName, defBits, typeName)
+	n := 0
+	for _, values := range runs {
+		for i := range values {
+			if v := &values[i]; v.value != 0 && v.value&(v.value-1) == 0 {
+				if n > 0 {
+					g.Printf(", ")
+				}
+				g.Printf("%s", v)
+				n++
+			}
+		}
+	}
+	g.Printf("}\n\n")
+	g.Printf(stringBitmask, typeName, g.CodeFnName, defCodeMap, defBits, g.Separator)
+	g.Printf("\n")
+	g.Printf(stringBitmask, typeName, g.NameFnName, defNameMap, defBits, g.Separator)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: map key
+//	[4]: bits key
+//	[5]: separator
+const stringBitmask = `func (i %[1]s) %[2]s() string {
+	if str, ok := _%[1]s%[3]s[i]; ok {
+		return str
+	}
+	var parts []string
+	rest := i
+	for _, bit := range _%[1]s%[4]s {
+		if rest&bit != 0 {
+			parts = append(parts, _%[1]s%[3]s[bit])
+			rest &^= bit
+		}
+	}
+	if len(parts) == 0 || rest != 0 {
+		return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return strings.Join(parts, %[5]q)
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function key
+//	[3]: string to value map key
+//	[4]: separator
+const stringBitmaskParse = `func _%[1]s%[2]s(str string) (%[1]s, bool) {
+	if val, ok := _%[1]s%[3]s[str]; ok {
+		return val, true
+	}
+	var val %[1]s
+	for _, part := range strings.Split(str, %[4]q) {
+		v, ok := _%[1]s%[3]s[part]
+		if !ok {
+			return 0, false
+		}
+		val |= v
+	}
+	return val, true
+}
+`
+
+// needCode2IDMap reports whether the code to value map has to be declared,
+// either for the CodeTo function or for the decoding methods built on it.
+func (g *generator) needCode2IDMap(typeName string) bool {
+	return g.Code2IDFnName != "-" || g.GenParse && (g.ParseFnName != "-" || g.MustParseName != "-") ||
+		g.GenJSON || g.GenText || g.GenGQL ||
+		g.sqlStorageOf() == SQLStorageCode
+}
+
+// code2ID generates the code to value map for values whose codes are
+// packed into a single string, followed by the CodeTo function.
+func (g *generator) code2ID(runs [][]enumValue, typeName string) {
+	if !g.needCode2IDMap(typeName) {
+		return
+	}
+
+	g.declareToIDMap(runs, typeName, false, defCode2IDMap, defCodeVal, valueCode)
+	if g.Bitmask {
+		g.Printf(stringBitmaskParse, typeName, defParseCodes, defCode2IDMap, g.Separator)
+		g.Printf("\n")
+	}
+	g.code2IDFn(typeName)
+}
+
+// code2ID2 is the multiple runs version of code2ID, where the codes
+// of each run are packed into a string of their own.
+func (g *generator) code2ID2(runs [][]enumValue, typeName string) {
+	if !g.needCode2IDMap(typeName) {
+		return
+	}
+
+	g.declareToIDMap(runs, typeName, true, defCode2IDMap, defCodeVal, valueCode)
+	g.code2IDFn(typeName)
+}
+
+// nameLookups reports whether the name to value map is needed, for the
+// NameTo function or for the ParseName function generated with GenParse.
+func (g *generator) nameLookups() bool {
+	return g.Name2IDFnName != "-" || g.GenParse && g.ParseNameFn != "-"
+}
+
+// name2ID generates the name to value map and the NameTo and ParseName
+// functions built on it. Two values sharing a name are reported by
+// checkDuplicates, as the mapping would be ambiguous.
+func (g *generator) name2ID(runs [][]enumValue, typeName string, multi bool) {
+	if !g.nameLookups() {
+		return
+	}
+
+	g.declareToIDMap(runs, typeName, multi, defName2IDMap, defNameVal, valueName)
+	if g.Bitmask {
+		g.Printf(stringBitmaskParse, typeName, defParseNames, defName2IDMap, g.Separator)
+		g.Printf("\n")
+	}
+
+	if g.Name2IDFnName != "-" {
+		fnName := g.Name2IDFnName
+		if fnName == "" {
+			fnName = fmt.Sprintf("%s%s", defName2IDFn, typeName)
+		}
+		g.Printf(stringName2IDMap, typeName, fnName, g.lookup(typeName, defName2IDMap, "name"))
+		g.Printf("\n")
+	}
+
+	if g.GenParse && g.ParseNameFn != "-" {
+		fnName := g.ParseNameFn
+		if fnName == "" {
+			fnName = fmt.Sprintf("%s%s%s", defParseFn, typeName, defNameFn)
+		}
+		g.addImport(lxEnumPkg)
+		g.Printf(stringParseName, typeName, fnName, g.lookup(typeName, defName2IDMap, "name"))
+		g.Printf("\n")
+	}
+}
+
+// declareToIDMap declares the map from the strings given by fn to values,
+// keyed by slices of the packed strings. If multi is set, each run is
+// packed into a string of its own, as done by declareIndexAndNameVars.
+// The aliases are left out, so their strings map to the values they alias.
+func (g *generator) declareToIDMap(
+	runs [][]enumValue,
+	typeName string,
+	multi bool,
+	mapKey, nameKey string,
+	fn func(*enumValue) string,
+) {
+	primary := primaryKeys(runs, fn)
+	g.Printf("\n")
+	g.Printf("\nvar _%s%s = map[string]%s{\n", typeName, mapKey, typeName)
+	n := 0
+	for i, values := range runs {
+		suffix := ""
+		if multi {
+			suffix = fmt.Sprintf("_%d", i)
+			if len(values) == 1 {
+				if !values[0].isAlias(primary, fn) {
+					g.Printf("\t_%s%s%s: %s,\n", typeName, nameKey, suffix, &values[0])
+				}
+				continue
+			}
+			n = 0
+		}
+		for _, value := range values {
+			if !value.isAlias(primary, fn) {
+				g.Printf("\t_%s%s%s[%d:%d]: %s,\n", typeName, nameKey, suffix, n, n+len(fn(&value)), &value)
+			}
+			n += len(fn(&value))
+		}
+	}
+	g.Printf("}\n\n")
+}
+
+// lookup returns the comma-ok expression looking up the value of the string
+// arg in the map of the given key. Bitmask types also accept the joined
+// strings of their bits.
+func (g *generator) lookup(typeName, mapKey, arg string) string {
+	if g.Bitmask {
+		fnKey := defParseCodes
+		if mapKey == defName2IDMap {
+			fnKey = defParseNames
+		}
+		return fmt.Sprintf("_%s%s(%s)", typeName, fnKey, arg)
+	}
+	return fmt.Sprintf("_%s%s[%s]", typeName, mapKey, arg)
+}
+
+// code2IDFn generates the CodeTo function, and the Parse and MustParse
+// functions if GenParse is set, each unless it is disabled with "-". The
+// latter return an *lxenum.UnknownCodeError, so the generated code depends on
+// lxenum only when asked to.
+func (g *generator) code2IDFn(typeName string) {
+	if g.Code2IDFnName != "-" {
+		fnName := g.Code2IDFnName
+		if fnName == "" {
+			fnName = fmt.Sprintf("%s%s", defCode2IDFn, typeName)
+		}
+		g.Printf(stringCode2IDMap, typeName, fnName, g.lookup(typeName, defCode2IDMap, "code"))
+		g.Printf("\n")
+	}
+
+	if g.GenParse && g.ParseFnName != "-" {
+		fnName := g.ParseFnName
+		if fnName == "" {
+			fnName = fmt.Sprintf("%s%s", defParseFn, typeName)
+		}
+		g.addImport(lxEnumPkg)
+		g.Printf(stringParse, typeName, fnName, g.lookup(typeName, defCode2IDMap, "code"))
+		g.Printf("\n")
+	}
+
+	if g.GenParse && g.MustParseName != "-" {
+		fnName := g.MustParseName
+		if fnName == "" {
+			fnName = fmt.Sprintf("%s%s", defMustParse, typeName)
+		}
+		g.addImport(lxEnumPkg)
+		g.Printf(stringMustParse, typeName, fnName, g.lookup(typeName, defCode2IDMap, "code"))
+		g.Printf("\n")
+	}
+}
+
+const stringCode2IDMap = `func %[2]s(code string, dftVal %[1]s) %[1]s {
+	if val, ok := %[3]s; ok {
+		return val
+	}
+	return dftVal
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: code to value lookup expression
+const stringParse = `func %[2]s(code string) (%[1]s, error) {
+	if val, ok := %[3]s; ok {
+		return val, nil
+	}
+	return 0, &lxenum.UnknownCodeError{Type: "%[1]s", Code: code}
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: name to value lookup expression
+const stringName2IDMap = `func %[2]s(name string, dftVal %[1]s) %[1]s {
+	if val, ok := %[3]s; ok {
+		return val
+	}
+	return dftVal
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: name to value lookup expression
+const stringParseName = `func %[2]s(name string) (%[1]s, error) {
+	if val, ok := %[3]s; ok {
+		return val, nil
+	}
+	return 0, &lxenum.UnknownNameError{Type: "%[1]s", Name: name}
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: code to value lookup expression
+const stringMustParse = `func %[2]s(code string) %[1]s {
+	if val, ok := %[3]s; ok {
+		return val
+	}
+	panic(&lxenum.UnknownCodeError{Type: "%[1]s", Code: code})
+}
+`
+
+// buildJSON generates the json.Marshaler and json.Unmarshaler implementations,
+// which encode a value as its code. An undeclared value fails to encode.
+func (g *generator) buildJSON(typeName string) {
+	g.addImport("encoding/json")
+	g.addImport("fmt")
+	g.addImport(lxEnumPkg)
+	g.Printf("\n")
+	g.Printf(stringJSON, typeName, g.CodeFnName, g.lookup(typeName, defCode2IDMap, "code"), g.IsValidFnName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: code function name
+//	[3]: code to value lookup expression
+//	[4]: is valid function name
+const stringJSON = `func (i %[1]s) MarshalJSON() ([]byte, error) {
+	if !i.%[4]s() {
+		return nil, fmt.Errorf("invalid %[1]s value %%d", i)
+	}
+	return json.Marshal(i.%[2]s())
+}
+
+func (i *%[1]s) UnmarshalJSON(data []byte) error {
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return fmt.Errorf("%[1]s should be a string, got %%s", data)
+	}
+	val, ok := %[3]s
+	if !ok {
+		return &lxenum.UnknownCodeError{Type: "%[1]s", Code: code}
+	}
+	*i = val
+	return nil
+}
+`
+
+// buildText generates the encoding.TextMarshaler and encoding.TextUnmarshaler
+// implementations, which encode a value as its code. An undeclared value
+// fails to encode.
+func (g *generator) buildText(typeName string) {
+	g.addImport("fmt")
+	g.addImport(lxEnumPkg)
+	g.Printf("\n")
+	g.Printf(stringText, typeName, g.CodeFnName, g.lookup(typeName, defCode2IDMap, "string(text)"), g.IsValidFnName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: code function name
+//	[3]: code to value lookup expression
+//	[4]: is valid function name
+const stringText = `func (i %[1]s) MarshalText() ([]byte, error) {
+	if !i.%[4]s() {
+		return nil, fmt.Errorf("invalid %[1]s value %%d", i)
+	}
+	return []byte(i.%[2]s()), nil
+}
+
+func (i *%[1]s) UnmarshalText(text []byte) error {
+	val, ok := %[3]s
+	if !ok {
+		return &lxenum.UnknownCodeError{Type: "%[1]s", Code: string(text)}
+	}
+	*i = val
+	return nil
+}
+`
+
+// sqlStorageOf returns the sql storage form of the type, or "" if no
+// Scan/Value methods are wanted.
+func (g *generator) sqlStorageOf() string {
+	if g.SQLStorage != "" {
+		return g.SQLStorage
+	}
+	if g.GenNull {
+		// The Null type scans through the methods of the type itself.
+		return SQLStorageInt
+	}
+	return ""
+}
+
+// buildIsValid generates the method reporting whether a value is one of
+// the declared constants, using the same layout as the Code and Name methods.
+func (g *generator) buildIsValid(runs [][]enumValue, typeName string) {
+	g.Printf("\n")
+	g.Printf("func (i %s) %s() bool {\n", typeName, g.IsValidFnName)
+	if g.Bitmask {
+		g.Printf(stringBitmaskIsValid, typeName, defCodeMap, defBits)
+		return
+	}
+	if len(runs) > 10 {
+		g.Printf("\t_, ok := _%s%s[i]\n", typeName, defCodeMap)
+		g.Printf("\treturn ok\n")
+		g.Printf("}\n")
+		return
+	}
+	g.Printf("\tswitch {\n")
+	for _, values := range runs {
+		switch {
+		case len(values) == 1:
+			g.Printf("\tcase i == %s:\n", &values[0])
+		case values[0].value == 0 && !values[0].signed:
+			// For an unsigned lower bound of 0, "0 <= i" would be redundant.
+			g.Printf("\tcase i <= %s:\n", &values[len(values)-1])
+		default:
+			g.Printf("\tcase %s <= i && i <= %s:\n", &values[0], &values[len(values)-1])
+		}
+		g.Printf("\t\treturn true\n")
+	}
+	g.Printf("\t}\n")
+	g.Printf("\treturn false\n")
+	g.Printf("}\n")
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: map key
+//	[3]: bits key
+const stringBitmaskIsValid = `	if _, ok := _%[1]s%[2]s[i]; ok {
+		return true
+	}
+	rest := i
+	for _, bit := range _%[1]s%[3]s {
+		rest &^= bit
+	}
+	return i != 0 && rest == 0
+}
+`
+
+// buildSQL generates the sql.Scanner and driver.Valuer implementations,
+// storing the value either as its integer or as its code.
+func (g *generator) buildSQL(typeName, storage string, signed bool) {
+	g.addImport("database/sql/driver")
+	g.addImport("fmt")
+	g.Printf("\n")
+	if storage == SQLStorageCode {
+		g.addImport(lxEnumPkg)
+		g.Printf(stringSQLCode, typeName, g.CodeFnName, g.lookup(typeName, defCode2IDMap, "src"), g.IsValidFnName)
+		return
+	}
+	parse, parsed, overflow := "strconv.ParseInt(src, 10, 64)", "int64", ""
+	if !signed {
+		// driver.Value holds an int64, so larger values can't be stored.
+		g.addImport("math")
+		parse, parsed = "strconv.ParseUint(src, 10, 64)", "uint64"
+		overflow = fmt.Sprintf(stringSQLOverflow, typeName)
+	}
+	g.Printf(stringSQLInt, typeName, parse, g.IsValidFnName, parsed, overflow)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: integer parsing expression of src
+//	[3]: is valid function name
+//	[4]: type of the parsed integer
+//	[5]: overflow check of the stored value, for unsigned types
+const stringSQLInt = `func (i *%[1]s) Scan(src interface{}) error {
+	var val %[1]s
+	switch src := src.(type) {
+	case int64:
+		val = %[1]s(src)
+		if int64(val) != src {
+			return fmt.Errorf("invalid %[1]s value %%d", src)
+		}
+	case []byte:
+		return i.Scan(string(src))
+	case string:
+		n, err := %[2]s
+		if err != nil {
+			return fmt.Errorf("%[1]s: cannot scan %%q: %%w", src, err)
+		}
+		val = %[1]s(n)
+		if %[4]s(val) != n {
+			return fmt.Errorf("invalid %[1]s value %%s", src)
+		}
+	default:
+		return fmt.Errorf("%[1]s: cannot scan type %%T", src)
+	}
+	if !val.%[3]s() {
+		return fmt.Errorf("invalid %[1]s value %%d", val)
+	}
+	*i = val
+	return nil
+}
+
+func (i %[1]s) Value() (driver.Value, error) {
+	if !i.%[3]s() {
+		return nil, fmt.Errorf("invalid %[1]s value %%d", i)
+	}
+%[5]s	return int64(i), nil
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+const stringSQLOverflow = `	if uint64(i) > math.MaxInt64 {
+		return nil, fmt.Errorf("%[1]s value %%d overflows int64", i)
+	}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: code function name
+//	[3]: code to value lookup expression
+//	[4]: is valid function name
+const stringSQLCode = `func (i *%[1]s) Scan(src interface{}) error {
+	var val %[1]s
+	switch src := src.(type) {
+	case int64:
+		val = %[1]s(src)
+		if int64(val) != src || !val.%[4]s() {
+			return fmt.Errorf("invalid %[1]s value %%d", src)
+		}
+	case []byte:
+		return i.Scan(string(src))
+	case string:
+		v, ok := %[3]s
+		if !ok {
+			return &lxenum.UnknownCodeError{Type: "%[1]s", Code: src}
+		}
+		val = v
+	default:
+		return fmt.Errorf("%[1]s: cannot scan type %%T", src)
+	}
+	*i = val
+	return nil
+}
+
+func (i %[1]s) Value() (driver.Value, error) {
+	if !i.%[4]s() {
+		return nil, fmt.Errorf("invalid %[1]s value %%d", i)
+	}
+	return i.%[2]s(), nil
+}
+`
+
+// buildNull generates the Null<Type> wrapper, in the style of sql.NullString,
+// along with its Scan/Value, JSON and code/name methods. The valid values are
+// encoded in JSON as the type encodes them: as codes with -json or -text, as
+// integers otherwise.
+func (g *generator) buildNull(typeName string) {
+	g.addImport("database/sql/driver")
+	g.addImport("encoding/json")
+	g.Printf("\n")
+	g.Printf(stringNull, typeName, g.CodeFnName, g.NameFnName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: code function name
+//	[3]: name function name
+const stringNull = `// Null%[1]s represents a %[1]s that may be null.
+type Null%[1]s struct {
+	%[1]s %[1]s
+	Valid bool // Valid is true if %[1]s is not NULL
+}
+
+func (n *Null%[1]s) Scan(src interface{}) error {
+	if src == nil {
+		n.%[1]s, n.Valid = 0, false
+		return nil
+	}
+	var val %[1]s
+	if err := val.Scan(src); err != nil {
+		return err
+	}
+	n.%[1]s, n.Valid = val, true
+	return nil
+}
+
+func (n Null%[1]s) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.%[1]s.Value()
+}
+
+func (n Null%[1]s) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.%[1]s)
+}
+
+func (n *Null%[1]s) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.%[1]s, n.Valid = 0, false
+		return nil
+	}
+	var val %[1]s
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	n.%[1]s, n.Valid = val, true
+	return nil
+}
+
+func (n Null%[1]s) %[2]s() string {
+	if !n.Valid {
+		return ""
+	}
+	return n.%[1]s.%[2]s()
+}
+
+func (n Null%[1]s) %[3]s() string {
+	if !n.Valid {
+		return ""
+	}
+	return n.%[1]s.%[3]s()
+}
+`
+
+// buildValues generates the functions listing the values, codes and names
+// of the type, in the order given.
+func (g *generator) buildValues(values []enumValue, typeName string) {
+	g.Printf("\n")
+	g.Printf("func %s%s() []%s {\n", typeName, defValuesFn, typeName)
+	g.Printf("\treturn []%s{\n", typeName)
+	for _, v := range values {
+		g.Printf("\t\t%s,\n", v.originalName)
+	}
+	g.Printf("\t}\n")
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf(stringValuesMap, typeName, defCodesFn, defValuesFn, g.CodeFnName)
+	g.Printf("\n")
+	g.Printf(stringValuesMap, typeName, defNamesFn, defValuesFn, g.NameFnName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function key
+//	[3]: values function key
+//	[4]: method called on each value
+const stringValuesMap = `func %[1]s%[2]s() []string {
+	values := %[1]s%[3]s()
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = v.%[4]s()
+	}
+	return strs
+}
+`
+
+// buildOptions generates the function listing the {Value, Code, Name} options
+// of the type in value order, without the values annotated as hidden.
+func (g *generator) buildOptions(runs [][]enumValue, typeName string) {
+	g.addImport(lxEnumPkg)
+	g.Printf("\n")
+	g.Printf("func %s%s() []lxenum.Option {\n", typeName, defOptionsFn)
+	g.Printf("\treturn []lxenum.Option{\n")
+	for _, values := range runs {
+		for _, v := range values {
+			if v.annotations[AnnotationHidden] {
+				continue
+			}
+			g.Printf(
+				"\t\t{Value: int64(%s), Code: %s.%s(), Name: %s.%s()},\n",
+				v.originalName, v.originalName, g.CodeFnName, v.originalName, g.NameFnName,
+			)
+		}
+	}
+	g.Printf("\t}\n")
+	g.Printf("}\n")
+}
+
+// buildMeta generates the method looking up the key=value attributes of the
+// comments. The values of each key are packed into a string, like the names.
+func (g *generator) buildMeta(runs [][]enumValue, typeName string) {
+	keys := g.declareKeyedTables(runs, typeName, defMetaVal, defMetaMap, func(v *enumValue) map[string]string {
+		return v.meta
+	})
+	if len(keys) == 0 {
+		return
+	}
+	g.Printf(stringMeta, typeName, g.MetaFnName, defMetaMap)
+}
+
+// buildNameIn generates the method returning the name in a given language.
+// The names of each language are packed into a string of their own; the
+// names given by the name function belong to the default -locale.
+func (g *generator) buildNameIn(runs [][]enumValue, typeName string) {
+	translated := false
+	for _, values := range runs {
+		for _, v := range values {
+			translated = translated || len(v.locales) > 0
+		}
+	}
+	if !translated {
+		return
+	}
+	g.declareKeyedTables(runs, typeName, defNameInVal, defNameInMap, func(v *enumValue) map[string]string {
+		if g.Locale == "" {
+			return v.locales
+		}
+		names := map[string]string{g.Locale: v.cnName}
+		for lang, name := range v.locales {
+			if lang != g.Locale {
+				names[lang] = name
+			}
+		}
+		return names
+	})
+	g.Printf("func (i %s) %s(lang string) string {\n", typeName, g.NameInFnName)
+	g.Printf("\tif str, ok := _%s%s[lang][i]; ok {\n", typeName, defNameInMap)
+	g.Printf("\t\treturn str\n")
+	g.Printf("\t}\n")
+	if len(g.Fallback) > 0 {
+		g.Printf("\tfor _, lang := range [...]string{")
+		for j, lang := range g.Fallback {
+			if j > 0 {
+				g.Printf(", ")
+			}
+			g.Printf("%q", lang)
+		}
+		g.Printf("} {\n")
+		g.Printf("\t\tif str, ok := _%s%s[lang][i]; ok {\n", typeName, defNameInMap)
+		g.Printf("\t\t\treturn str\n")
+		g.Printf("\t\t}\n")
+		g.Printf("\t}\n")
+	}
+	g.Printf("\treturn i.%s()\n", g.NameFnName)
+	g.Printf("}\n")
+}
+
+// declareKeyedTables declares, for each key of the maps given by fn, a string
+// packing the values of that key and a map from key to the values of the type
+// to slices of that string. It returns the sorted keys, if any.
+func (g *generator) declareKeyedTables(
+	runs [][]enumValue,
+	typeName string,
+	nameKey, mapKey string,
+	fn func(*enumValue) map[string]string,
+) []string {
+	keySet := make(map[string]bool)
+	for _, values := range runs {
+		for i := range values {
+			for key := range fn(&values[i]) {
+				keySet[key] = true
+			}
+		}
+	}
+	if len(keySet) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	g.Printf("\n")
+	g.Printf("const (\n")
+	for k, key := range keys {
+		var b strings.Builder
+		for _, values := range runs {
+			for i := range values {
+				b.WriteString(fn(&values[i])[key])
+			}
+		}
+		g.Printf("\t_%s%s_%d = %q\n", typeName, nameKey, k, b.String())
+	}
+	g.Printf(")\n")
+
+	g.Printf("\nvar _%s%s = map[string]map[%s]string{\n", typeName, mapKey, typeName)
+	for k, key := range keys {
+		g.Printf("\t%q: {\n", key)
+		n := 0
+		for _, values := range runs {
+			for i := range values {
+				str, ok := fn(&values[i])[key]
+				if !ok {
+					continue
+				}
+				g.Printf("\t\t%s: _%s%s_%d[%d:%d],\n", &values[i], typeName, nameKey, k, n, n+len(str))
+				n += len(str)
+			}
+		}
+		g.Printf("\t},\n")
+	}
+	g.Printf("}\n\n")
+	return keys
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: meta function name
+//	[3]: meta map key
+const stringMeta = `func (i %[1]s) %[2]s(key string) string {
+	return _%[1]s%[3]s[key][i]
+}
+`
@@ -0,0 +1,475 @@
+package generator
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+// sourceGenerator returns a generator of the package made of the source,
+// named p.go, without loading it from disk.
+func sourceGenerator(t *testing.T, src string, args []string) *generator {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", src, parser.ParseComments)
+	require.Nil(t, err)
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	_, err = new(types.Config).Check("p", fset, []*ast.File{file}, info)
+	require.Nil(t, err)
+
+	g := &generator{args: args, files: make(map[string][]byte)}
+	g.addPackage(&packages.Package{Name: "p", Fset: fset, Syntax: []*ast.File{file}, TypesInfo: info})
+	return g
+}
+
+// generateSource generates the types of the config from a package made of
+// the source, named p.go, without loading it from disk.
+func generateSource(t *testing.T, src string, cfg Config) (files map[string][]byte, err error) {
+	g := sourceGenerator(t, src, cfg.Args)
+	defer recoverError(&err)
+	return g.generateConfig(cfg, ".")
+}
+
+const pillSource = `package p
+
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂
+	Aspirin             // aspirin 阿司匹林 en:"Aspirin" color=white
+)
+`
+
+func TestGenerate(t *testing.T) {
+	files, err := generateSource(t, pillSource, Config{
+		Args:  []string{"-type=Pill"},
+		Types: []TypeOptions{{Name: "Pill", Options: Options{GenJSON: true}}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, len(files), 1)
+
+	src := string(files["pill_string.go"])
+	require.True(t, strings.HasPrefix(src, `// Code generated by "stringer -type=Pill"; DO NOT EDIT.`))
+	require.Contains(t, src, `_PillCodeName = "placeboaspirin"`)
+	require.Contains(t, src, `_PillName     = "安慰剂阿司匹林"`)
+	require.Contains(t, src, `func (i Pill) MarshalJSON() ([]byte, error)`)
+	require.Contains(t, src, `_PillMeta_0 = "white"`)
+	require.Contains(t, src, `_PillNameIn_0 = "Aspirin"`)
+
+	// Without arguments, the comment only names the command.
+	files, err = generateSource(t, pillSource, Config{Types: []TypeOptions{{Name: "Pill"}}})
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(string(files["pill_string.go"]), `// Code generated by "stringer"; DO NOT EDIT.`))
+}
+
+func TestGenerateDirective(t *testing.T) {
+	files, err := generateSource(t, `package p
+
+//lxstringer:enum code=Label json
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂
+	Aspirin             // aspirin 阿司匹林
+)
+`, Config{Output: "enums.go"})
+	require.Nil(t, err)
+
+	src := string(files["enums.go"])
+	require.Contains(t, src, `func (i Pill) Label() string`)
+	require.Contains(t, src, `func (i Pill) MarshalJSON() ([]byte, error)`)
+}
+
+func TestGenerateNoTypes(t *testing.T) {
+	_, err := generateSource(t, pillSource, Config{})
+	require.Equal(t, err, ErrNoTypes)
+
+	_, err = generateSource(t, pillSource, Config{
+		Types:       []TypeOptions{{Name: "Other"}},
+		SkipMissing: true,
+	})
+	require.Equal(t, err, ErrNoTypes)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		opts Options
+		want string
+	}{
+		{pillSource, Options{SQLStorage: "text"}, `p.go:3:6: invalid sql storage "text" for type Pill, must be int or code`},
+		{pillSource, Options{ValuesOrder: "name"}, `p.go:3:6: invalid values order "name" for type Pill, must be value or decl`},
+		{pillSource, Options{IsValidFnName: "-", GenNull: true}, `p.go:3:6: can't skip the is valid function of type Pill, the sql methods need it`},
+		{pillSource, Options{IsValidFnName: "-", GenJSON: true}, `p.go:3:6: can't skip the is valid function of type Pill, the json and text methods need it`},
+		{`package p
+
+type Pill float64
+
+const Placebo Pill = 0 // placebo 安慰剂
+`, Options{}, `p.go:5:7: can't handle non-integer constant type Pill`},
+		{`package p
+
+type Pill int
+
+const Placebo Pill = 0 // placebo 安慰剂 @unknown
+`, Options{}, `p.go:5:24: unknown annotation @unknown for constant Placebo`},
+	}
+	for _, tt := range tests {
+		_, err := generateSource(t, tt.src, Config{Types: []TypeOptions{{Name: "Pill", Options: tt.opts}}})
+		list, ok := err.(ErrorList)
+		require.True(t, ok)
+		require.Equal(t, len(list), 1)
+		require.Equal(t, err.Error(), tt.want)
+	}
+}
+
+func TestGenerateDirectiveError(t *testing.T) {
+	_, err := generateSource(t, `package p
+
+//lxstringer:enum json=maybe
+type Pill int
+
+const Placebo Pill = 0 // placebo 安慰剂
+`, Config{})
+	list, ok := err.(ErrorList)
+	require.True(t, ok)
+	require.Equal(t, list[0].Pos.Line, 3)
+	require.Contains(t, list[0].Msg, "json")
+
+	// A directive after the doc paragraph is reported at its own line.
+	_, err = generateSource(t, `package p
+
+// Pill is a pill.
+//
+//lxstringer:enum json=maybe
+type Pill int
+
+const Placebo Pill = 0 // placebo 安慰剂
+`, Config{})
+	require.Equal(t, err.Error(), `p.go:5:1: invalid value "maybe" of key "json" in //lxstringer:enum of type Pill`)
+}
+
+func TestGenerateErrorList(t *testing.T) {
+	_, err := generateSource(t, `package p
+
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂 @unknown
+	Aspirin             // aspirin 阿司匹林 @other
+)
+
+type Dose float64
+
+const Low Dose = 0.5 // low 低
+`, Config{Types: []TypeOptions{{Name: "Pill"}, {Name: "Dose"}, {Name: "Other"}}})
+	var list ErrorList
+	require.True(t, errors.As(err, &list))
+	require.Equal(t, err.Error(), strings.Join([]string{
+		"no values defined for type Other",
+		"p.go:6:22: unknown annotation @unknown for constant Placebo",
+		"p.go:7:22: unknown annotation @other for constant Aspirin",
+		"p.go:12:7: can't handle non-integer constant type Dose",
+	}, "\n"))
+}
+
+func TestGenerateDuplicates(t *testing.T) {
+	src := `package p
+
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂
+	Aspirin             // aspirin 安慰剂
+	Dummy               // placebo 假药
+	Sugar   = Placebo   // sugar 糖丸
+)
+`
+	_, err := generateSource(t, src, Config{Types: []TypeOptions{{Name: "Pill"}}})
+	require.Equal(t, err.Error(), strings.Join([]string{
+		`p.go:7:2: constant Aspirin has the same name "安慰剂" as Placebo at p.go:6:2, mark it @alias if intended or use -nametoid=- -parsename=- to skip name lookups`,
+		`p.go:8:2: constant Dummy has the same code "placebo" as Placebo at p.go:6:2, mark it @alias if intended`,
+	}, "\n"))
+
+	// ParsePillName needs the names as well.
+	_, err = generateSource(t, strings.Replace(src, "// placebo 假药", "// dummy 假药", 1),
+		Config{Types: []TypeOptions{{Name: "Pill", Options: Options{Name2IDFnName: "-", GenParse: true}}}})
+	require.Equal(t, err.Error(), `p.go:7:2: constant Aspirin has the same name "安慰剂" as Placebo at p.go:6:2, mark it @alias if intended or use -nametoid=- -parsename=- to skip name lookups`)
+
+	files, err := generateSource(t, strings.Replace(src, "// placebo 假药", "// dummy 假药", 1),
+		Config{Types: []TypeOptions{{Name: "Pill", Options: Options{Name2IDFnName: "-", GenParse: true, ParseNameFn: "-"}}}})
+	require.Nil(t, err)
+	require.NotContains(t, string(files["pill_string.go"]), "NameToPill")
+	require.NotContains(t, string(files["pill_string.go"]), "ParsePillName")
+	require.Contains(t, string(files["pill_string.go"]), "func ParsePill(")
+
+	files, err = generateSource(t, strings.Replace(strings.Replace(src,
+		"// aspirin 安慰剂", "// aspirin 安慰剂 @alias", 1),
+		"// placebo 假药", "// placebo 假药 @alias", 1),
+		Config{Types: []TypeOptions{{Name: "Pill"}}})
+	require.Nil(t, err)
+	src = string(files["pill_string.go"])
+	require.Contains(t, src, "var _PillCode2IDMap = map[string]Pill{\n\t_PillCodeName[0:7]:  0,\n\t_PillCodeName[7:14]: 1,\n}")
+	require.Contains(t, src, "var _PillName2IDMap = map[string]Pill{\n\t_PillName[0:9]:   0,\n\t_PillName[18:24]: 2,\n}")
+
+	_, err = generateSource(t, `package p
+
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂 @alias
+	Dummy               // placebo 假药 @alias
+)
+`, Config{Types: []TypeOptions{{Name: "Pill"}}})
+	require.Equal(t, err.Error(), `p.go:7:2: constant Dummy has the same code "placebo" as Placebo at p.go:6:2 and both are marked @alias, leave the one to look up unmarked`)
+}
+
+func TestGenerateAliasExports(t *testing.T) {
+	src := `package p
+
+type Pay int
+
+const (
+	PayWechat Pay = iota // wechat 微信支付
+	PayAlipay            // alipay 支付宝
+	PayWxMini            // wechat 小程序 @alias
+)
+`
+	// The exports keyed by code leave the alias out, the others list every value.
+	files, err := generateSource(t, src, Config{
+		Types:   []TypeOptions{{Name: "Pay"}},
+		Exports: Exports{TS: "p.ts", Doc: "p.md"},
+	})
+	require.Nil(t, err)
+	require.NotContains(t, string(files["p.ts"]), "小程序")
+	require.Contains(t, string(files["p.md"]), "| PayWxMini | 2 | wechat | 小程序 |")
+
+	// The pb conversions need a counterpart for the alias too.
+	g := sourceGenerator(t, src, nil)
+	opts := Options{}
+	require.Nil(t, opts.normalize("Pay"))
+	g.generate("Pay", opts)
+	require.Equal(t, len(g.enums[0].values), 3)
+	g.buildPB(g.enums[0].values, "Pay", &pbEnum{pkgName: "pb", pkgPath: "x/pb", name: "Pay", consts: []pbConst{
+		{name: "Pay_PAY_WECHAT", value: 0}, {name: "Pay_PAY_ALIPAY", value: 1},
+	}}, PBMatchCode)
+	require.Equal(t, g.pkg.errs.Error(), "p.go:8:2: constant PayWxMini has no counterpart in pb.Pay, its code matches pb.Pay_PAY_WECHAT of PayWechat; use -pbmatch=name")
+}
+
+func TestParseComment(t *testing.T) {
+	require.Equal(t, ParseComment(`@hidden freezing "冻结 中" en:"Frozen" color=blue @admin extra`, false), Comment{
+		Code:        "freezing",
+		Name:        "冻结 中",
+		Annotations: []string{"hidden", "admin"},
+		Meta:        map[string]string{"color": "blue"},
+		Locales:     map[string]string{"en": "Frozen"},
+		Extras:      []string{"extra"},
+	})
+	require.Equal(t, ParseComment("冻结中 extra", true), Comment{Code: "冻结中", Name: "冻结中", Extras: []string{"extra"}})
+}
+
+func TestGenerateLocales(t *testing.T) {
+	files, err := generateSource(t, `package p
+
+type Tier int
+
+const (
+	Gold   Tier = iota // vip:gold 金卡 en:"Gold"
+	Silver             // api:read zh:"银卡"
+)
+`, Config{Types: []TypeOptions{{Name: "Tier", Options: Options{Locale: "zh"}}}})
+	require.Nil(t, err)
+
+	src := string(files["tier_string.go"])
+	require.Contains(t, src, `_TierCodeName = "vip:goldapi:read"`)
+	require.Contains(t, src, `_TierName     = "金卡银卡"`)
+	require.Contains(t, src, `_TierNameIn_0 = "Gold"`)
+}
+
+func TestGenerateMeta(t *testing.T) {
+	files, err := generateSource(t, `package p
+
+type Op int
+
+const (
+	Assign Op = iota // a=b 赋值 color=red
+	Equal            // a==b 等于
+)
+`, Config{Types: []TypeOptions{{Name: "Op"}}})
+	require.Nil(t, err)
+
+	src := string(files["op_string.go"])
+	require.Contains(t, src, `_OpCodeName = "a=ba==b"`)
+	require.Contains(t, src, `_OpName     = "赋值等于"`)
+	require.Contains(t, src, `_OpMeta_0 = "red"`)
+}
+
+func TestGenerateAnnotations(t *testing.T) {
+	files, err := generateSource(t, `package p
+
+type Role int
+
+const (
+	Admin Role = iota // @admin 管理员
+	Guest             // guest @hidden
+)
+`, Config{Types: []TypeOptions{{Name: "Role", Options: Options{GenOptions: true}}}})
+	require.Nil(t, err)
+
+	src := string(files["role_string.go"])
+	require.Contains(t, src, `_RoleCodeName = "@adminguest"`)
+	require.Contains(t, src, `_RoleName     = "管理员Guest"`)
+	require.Contains(t, src, "{Value: int64(Admin)")
+	require.NotContains(t, src, "{Value: int64(Guest)")
+}
+
+func TestGenerateOptionsOverflow(t *testing.T) {
+	src := `package p
+
+type Flag uint64
+
+const (
+	Low  Flag = 1       // low 低
+	High Flag = 1 << 63 // high 高
+)
+`
+	_, err := generateSource(t, src, Config{Types: []TypeOptions{{Name: "Flag", Options: Options{GenOptions: true}}}})
+	require.Equal(t, err.Error(), "p.go:7:2: value 9223372036854775808 of constant High overflows the int64 Value of -options, mark it @hidden to leave it out")
+
+	_, err = generateSource(t, strings.Replace(src, "// high 高", "// high 高 @hidden", 1),
+		Config{Types: []TypeOptions{{Name: "Flag", Options: Options{GenOptions: true}}}})
+	require.Nil(t, err)
+}
+
+func TestBuildPBMismatch(t *testing.T) {
+	buildPB := func(values []enumValue, consts ...pbConst) ErrorList {
+		g := generator{pkg: &parsedPackage{name: "p", fset: token.NewFileSet()}}
+		g.buildPB(values, "Status", &pbEnum{pkgName: "pb", pkgPath: "x/pb", name: "Status", consts: consts}, PBMatchCode)
+		return g.pkg.errs
+	}
+	frozen := enumValue{originalName: "Frozen", codeName: "frozen", value: 1}
+	active := enumValue{originalName: "Active", codeName: "active", value: 2}
+
+	require.Nil(t, buildPB([]enumValue{frozen, active},
+		pbConst{name: "Status_STATUS_FROZEN", value: 1}, pbConst{name: "Status_STATUS_ACTIVE", value: 2}))
+
+	errs := buildPB([]enumValue{frozen, active},
+		pbConst{name: "Status_STATUS_FROZEN", value: 1}, pbConst{name: "Status_STATUS_ACTIVE", value: 2},
+		pbConst{name: "Status_STATUS_ENABLED", value: 2})
+	require.Equal(t, errs.Error(), "pb.Status_STATUS_ENABLED is an alias of pb.Status_STATUS_ACTIVE")
+
+	errs = buildPB([]enumValue{frozen, {originalName: "Frozen2", codeName: "STATUS_FROZEN", value: 2}},
+		pbConst{name: "Status_STATUS_FROZEN", value: 1}, pbConst{name: "Status_STATUS_ACTIVE", value: 2})
+	require.Equal(t, errs.Error(), strings.Join([]string{
+		"constants Frozen and Frozen2 both match pb.Status_STATUS_FROZEN",
+		"pb.Status_STATUS_ACTIVE has no counterpart in Status",
+	}, "\n"))
+}
+
+func TestGenerateExportErrors(t *testing.T) {
+	_, err := generateSource(t, `package p
+
+type Level int
+
+const (
+	Low  Level = iota + 1 // low 低
+	High                  // high 高
+)
+
+type Mode int
+
+const (
+	ReadOnly  Mode = iota // read-only 只读
+	ReadOnly2             // read_only 只读2
+)
+
+type Size int
+
+const Small Size = 0 // small 小 @unknown
+`, Config{
+		Types:   []TypeOptions{{Name: "Level"}, {Name: "Mode", Options: Options{GenGQL: true}}, {Name: "Size"}},
+		Exports: Exports{Proto: "p.proto"},
+	})
+	require.Equal(t, err.Error(), strings.Join([]string{
+		"p.go:3:6: type Level has no zero value, which proto3 requires",
+		"p.go:14:2: constants ReadOnly and ReadOnly2 have the same GraphQL symbol READ_ONLY",
+		"p.go:14:2: constants ReadOnly and ReadOnly2 have the same proto symbol MODE_READ_ONLY",
+		"p.go:19:22: unknown annotation @unknown for constant Small",
+	}, "\n"))
+}
+
+func TestGenerateSchema(t *testing.T) {
+	src := `package p
+
+type Pill int
+
+const (
+	Placebo Pill = iota // placebo 安慰剂
+	Aspirin             // aspirin 阿司匹林
+)
+`
+	files, err := generateSource(t, src, Config{Types: []TypeOptions{{Name: "Pill"}}, Exports: Exports{Schema: "p.json"}})
+	require.Nil(t, err)
+	require.Contains(t, string(files["p.json"]), `"type": "integer",
+      "enum": [
+        0,
+        1
+      ],`)
+
+	files, err = generateSource(t, src, Config{Types: []TypeOptions{{Name: "Pill", Options: Options{GenText: true}}}, Exports: Exports{Schema: "p.json"}})
+	require.Nil(t, err)
+	require.Contains(t, string(files["p.json"]), `"type": "string",
+      "enum": [
+        "placebo",
+        "aspirin"
+      ],`)
+}
+
+func TestGenerateParse(t *testing.T) {
+	files, err := generateSource(t, pillSource, Config{Types: []TypeOptions{{Name: "Pill"}}})
+	require.Nil(t, err)
+	require.NotContains(t, string(files["pill_string.go"]), "func ParsePill(")
+	require.NotContains(t, string(files["pill_string.go"]), "func MustParsePill(")
+	require.NotContains(t, string(files["pill_string.go"]), lxEnumPkg)
+
+	files, err = generateSource(t, pillSource, Config{Types: []TypeOptions{{Name: "Pill", Options: Options{GenParse: true}}}})
+	require.Nil(t, err)
+	require.Contains(t, string(files["pill_string.go"]), "func ParsePill(code string) (Pill, error) {")
+	require.Contains(t, string(files["pill_string.go"]), "func MustParsePill(code string) Pill {")
+	require.Contains(t, string(files["pill_string.go"]), "func ParsePillName(name string) (Pill, error) {")
+}
+
+func TestGenerateSkipIsValid(t *testing.T) {
+	files, err := generateSource(t, pillSource, Config{Types: []TypeOptions{{Name: "Pill", Options: Options{IsValidFnName: "-"}}}})
+	require.Nil(t, err)
+	require.NotContains(t, string(files["pill_string.go"]), "IsValid")
+	require.NotContains(t, string(files["pill_string.go"]), "func (i Pill) -")
+}
+
+func TestGenerateSQLOverflow(t *testing.T) {
+	src := `package p
+
+type Flag uint64
+
+const (
+	Low  Flag = 1       // low 低
+	High Flag = 1 << 63 // high 高
+)
+`
+	files, err := generateSource(t, src, Config{Types: []TypeOptions{{Name: "Flag", Options: Options{SQLStorage: SQLStorageInt}}}})
+	require.Nil(t, err)
+	require.Contains(t, string(files["flag_string.go"]), `	if uint64(i) > math.MaxInt64 {
+		return nil, fmt.Errorf("Flag value %d overflows int64", i)
+	}
+	return int64(i), nil`)
+
+	files, err = generateSource(t, pillSource, Config{Types: []TypeOptions{{Name: "Pill", Options: Options{SQLStorage: SQLStorageInt}}}})
+	require.Nil(t, err)
+	require.NotContains(t, string(files["pill_string.go"]), "math.MaxInt64")
+}
@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// gqlSymbol returns the GraphQL enum value name of v, derived from its code,
+// or from the constant name if the code has no usable characters.
+func gqlSymbol(v *enumValue) string {
+	symbol := upperSnake(v.codeName)
+	if symbol == "" {
+		symbol = upperSnake(v.originalName)
+	}
+	switch {
+	case symbol[0] >= '0' && symbol[0] <= '9':
+		// Names can't start with a digit.
+		symbol = "_" + symbol
+	case symbol == "TRUE" || symbol == "FALSE" || symbol == "NULL":
+		// GraphQL only reserves the lowercase forms, but keep clear of them anyway.
+		symbol += "_"
+	}
+	return symbol
+}
+
+// gqlSymbols returns the GraphQL symbols of the values, reporting the
+// constants whose symbols collide.
+func (g *generator) gqlSymbols(values []enumValue) []string {
+	symbols := make([]string, len(values))
+	seen := make(map[string]string)
+	for i := range values {
+		v := &values[i]
+		symbols[i] = gqlSymbol(v)
+		if prev, ok := seen[symbols[i]]; ok {
+			g.pkg.errorf(v.pos, "constants %s and %s have the same GraphQL symbol %s", prev, v.originalName, symbols[i])
+		}
+		seen[symbols[i]] = v.originalName
+	}
+	return symbols
+}
+
+// writeGraphQL writes the generated types as GraphQL enums to the named file,
+// with the names as descriptions. The symbols are derived from the codes, so
+// the aliases are left out.
+func (g *generator) writeGraphQL(name string) {
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, "# %s\n", g.generatedComment())
+	for i := range g.enums {
+		enum := &g.enums[i]
+		values := enum.codeValues()
+		symbols := g.gqlSymbols(values)
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "enum %s {\n", enum.name)
+		for j := range values {
+			fmt.Fprintf(b, "  %s %s\n", strconv.Quote(values[j].cnName), symbols[j])
+		}
+		fmt.Fprintf(b, "}\n")
+	}
+
+	g.files[name] = b.Bytes()
+}
+
+// buildGQL generates the gqlgen Marshaler and Unmarshaler implementations,
+// which translate the codes to and from the GraphQL symbols. An alias is
+// marshalled as the value whose code it shares. MarshalGQL can't return an
+// error, so a value without a symbol, such as an undeclared one, is
+// marshalled as null.
+func (g *generator) buildGQL(enum *enumType) {
+	typeName := enum.name
+	values := enum.codeValues()
+	symbols := g.gqlSymbols(values)
+	g.addImport("fmt")
+	g.addImport("io")
+	g.addImport(lxEnumPkg)
+
+	g.Printf("\n")
+	g.Printf("var _%s%s = map[string]string{\n", typeName, defCodeGQLMap)
+	for i := range values {
+		g.Printf("\t%q: %q,\n", values[i].codeName, symbols[i])
+	}
+	g.Printf("}\n\n")
+	g.Printf("var _%s%s = map[string]string{\n", typeName, defGQLCodeMap)
+	for i := range values {
+		g.Printf("\t%q: %q,\n", symbols[i], values[i].codeName)
+	}
+	g.Printf("}\n\n")
+	g.Printf(stringGQL, typeName, g.CodeFnName, defCodeGQLMap, defGQLCodeMap, g.lookup(typeName, defCode2IDMap, "code"))
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: code function name
+//	[3]: code to symbol map key
+//	[4]: symbol to code map key
+//	[5]: code to value lookup expression
+const stringGQL = `func (i %[1]s) MarshalGQL(w io.Writer) {
+	symbol, ok := _%[1]s%[3]s[i.%[2]s()]
+	if !ok {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.Quote(symbol))
+}
+
+func (i *%[1]s) UnmarshalGQL(v interface{}) error {
+	symbol, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("%[1]s must be a string, got %%T", v)
+	}
+	code, ok := _%[1]s%[4]s[symbol]
+	if !ok {
+		return &lxenum.UnknownCodeError{Type: "%[1]s", Code: symbol}
+	}
+	val, ok := %[5]s
+	if !ok {
+		return &lxenum.UnknownCodeError{Type: "%[1]s", Code: code}
+	}
+	*i = val
+	return nil
+}
+`
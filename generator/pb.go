@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"context"
+	"go/constant"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Match modes of the PBMatch option.
+const (
+	PBMatchCode = "code"
+	PBMatchName = "name"
+)
+
+// pbEnum is a protoc-gen-go enum type and its constants.
+type pbEnum struct {
+	pkgName string // Name of the package declaring the type.
+	pkgPath string // Import path of that package.
+	name    string // Name of the type.
+	consts  []pbConst
+}
+
+// pbConst is a constant of a pbEnum.
+type pbConst struct {
+	name  string // Name of the Go constant, e.g. Status_STATUS_FROZEN.
+	value int64
+}
+
+// loadPBEnum loads the enum type named by target, an import path followed by
+// a dot and the type name, such as github.com/x/pb.Status.
+func loadPBEnum(ctx context.Context, target string) *pbEnum {
+	i := strings.LastIndex(target, ".")
+	if i <= 0 || i < strings.LastIndex(target, "/") {
+		failf("invalid pb target %q, must be importpath.Type", target)
+	}
+	pkgPath, typeName := target[:i], target[i+1:]
+	cfg := &packages.Config{Context: ctx, Mode: packages.NeedName | packages.NeedTypes}
+	pkgs, err := packages.Load(cfg, pkgPath)
+	if err != nil {
+		fail(&Error{Msg: "loading packages", Err: err})
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		failf("can't load package %s", pkgPath)
+	}
+	pkg := pkgs[0]
+	obj, ok := pkg.Types.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		failf("no type %s in package %s", typeName, pkgPath)
+	}
+
+	enum := &pbEnum{pkgName: pkg.Name, pkgPath: pkg.PkgPath, name: typeName}
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), obj.Type()) {
+			continue
+		}
+		value, ok := constant.Int64Val(c.Val())
+		if !ok {
+			failf("value of %s is not an int64: %s", name, c.Val())
+		}
+		enum.consts = append(enum.consts, pbConst{name: name, value: value})
+	}
+	if len(enum.consts) == 0 {
+		failf("no constants of type %s in package %s", typeName, pkgPath)
+	}
+	sort.SliceStable(enum.consts, func(i, j int) bool {
+		return enum.consts[i].value < enum.consts[j].value
+	})
+	return enum
+}
+
+// matchKey normalizes s into the key used to match Go and proto constants:
+// its UPPER_SNAKE_CASE form without the prefix of the enum type.
+func matchKey(s, prefix string) string {
+	return strings.TrimPrefix(upperSnake(s), prefix+"_")
+}
+
+// buildPB generates the ToPB method and FromPB function converting between
+// the values of the type and the constants of the protobuf enum. Like the
+// CodeTo function, both return the given default for an undeclared value,
+// as the zero value may be a declared one on either side. Values are
+// matched by code or by constant name, and generation fails unless every
+// value on each side has exactly one counterpart: the switches of both
+// functions can't have duplicate cases. The values include the aliases,
+// which ToPB has to convert as well. The problems are reported at the
+// values, or at the type for the proto constants.
+func (g *generator) buildPB(values []enumValue, typeName string, enum *pbEnum, match string) {
+	errs := len(g.pkg.errs)
+	typePos := g.pkg.typePos(typeName)
+	matched := make(map[string]string) // The original names of the values, by the name of the proto constants.
+
+	pbPrefix := upperSnake(enum.name)
+	pbByKey := make(map[string]*pbConst)
+	pbByValue := make(map[int64]*pbConst)
+	for i := range enum.consts {
+		c := &enum.consts[i]
+		if prev, ok := pbByValue[c.value]; ok {
+			g.pkg.errorf(typePos, "%s.%s is an alias of %s.%s", enum.pkgName, c.name, enum.pkgName, prev.name)
+			matched[c.name] = ""
+			continue
+		}
+		pbByValue[c.value] = c
+		key := matchKey(strings.TrimPrefix(c.name, enum.name+"_"), pbPrefix)
+		if prev, ok := pbByKey[key]; ok {
+			g.pkg.errorf(typePos, "%s.%s and %s.%s both match %s", enum.pkgName, prev.name, enum.pkgName, c.name, key)
+			matched[c.name] = ""
+			continue
+		}
+		pbByKey[key] = c
+	}
+
+	pairs := make(map[string]*pbConst) // By the original name of the values.
+	for i := range values {
+		v := &values[i]
+		var key string
+		switch match {
+		case PBMatchName:
+			key = matchKey(v.originalName, upperSnake(typeName))
+		default:
+			key = matchKey(v.codeName, pbPrefix)
+			if key == "" {
+				key = matchKey(v.originalName, upperSnake(typeName))
+			}
+		}
+		c, ok := pbByKey[key]
+		if !ok {
+			g.pkg.errorf(v.pos, "constant %s has no counterpart in %s.%s", v.originalName, enum.pkgName, enum.name)
+			continue
+		}
+		if prev, ok := matched[c.name]; ok {
+			if v.annotations[AnnotationAlias] && match == PBMatchCode {
+				// The code of an alias is that of another value, so it can't have one of its own.
+				g.pkg.errorf(
+					v.pos, "constant %s has no counterpart in %s.%s, its code matches %s.%s of %s; use -pbmatch=%s",
+					v.originalName, enum.pkgName, enum.name, enum.pkgName, c.name, prev, PBMatchName,
+				)
+				continue
+			}
+			g.pkg.errorf(v.pos, "constants %s and %s both match %s.%s", prev, v.originalName, enum.pkgName, c.name)
+			continue
+		}
+		pairs[v.originalName] = c
+		matched[c.name] = v.originalName
+	}
+	for _, c := range enum.consts {
+		if _, ok := matched[c.name]; !ok {
+			g.pkg.errorf(typePos, "%s.%s has no counterpart in %s", enum.pkgName, c.name, typeName)
+		}
+	}
+	if len(g.pkg.errs) > errs {
+		return
+	}
+
+	alias := ""
+	if enum.pkgName != path.Base(enum.pkgPath) {
+		alias = enum.pkgName
+	}
+	g.addNamedImport(alias, enum.pkgPath)
+
+	g.Printf("\n")
+	g.Printf("func (i %s) %s(dftVal %s.%s) %s.%s {\n", typeName, defToPBFn, enum.pkgName, enum.name, enum.pkgName, enum.name)
+	g.Printf("\tswitch i {\n")
+	for _, v := range values {
+		g.Printf("\tcase %s:\n", v.originalName)
+		g.Printf("\t\treturn %s.%s\n", enum.pkgName, pairs[v.originalName].name)
+	}
+	g.Printf("\t}\n")
+	g.Printf("\treturn dftVal\n")
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("func %s%s(v %s.%s, dftVal %s) %s {\n", typeName, defFromPBFn, enum.pkgName, enum.name, typeName, typeName)
+	g.Printf("\tswitch v {\n")
+	for _, v := range values {
+		g.Printf("\tcase %s.%s:\n", enum.pkgName, pairs[v.originalName].name)
+		g.Printf("\t\treturn %s\n", v.originalName)
+	}
+	g.Printf("\t}\n")
+	g.Printf("\treturn dftVal\n")
+	g.Printf("}\n")
+}
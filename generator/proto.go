@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// writeProto writes the generated types as proto3 enums to the named file.
+// Symbols are derived from the codes, prefixed with the type name as the
+// protobuf style guide asks, and the names become trailing comments; the
+// aliases, whose codes are those of other values, are left out. The values
+// proto3 can't represent are reported at their constants.
+func (g *generator) writeProto(name, protoPackage string) {
+	if protoPackage == "" {
+		protoPackage = g.pkg.name
+	}
+
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, "// %s\n", g.generatedComment())
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "syntax = \"proto3\";\n")
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "package %s;\n", protoPackage)
+	for _, enum := range g.enums {
+		prefix := upperSnake(enum.name)
+		enumValues := enum.codeValues()
+		// proto3 requires the first value to be zero, the others follow in value order.
+		values := make([]*enumValue, 0, len(enumValues))
+		for i := range enumValues {
+			if enumValues[i].value == 0 {
+				values = append(values, &enumValues[i])
+			}
+		}
+		if len(values) == 0 {
+			g.pkg.errorf(g.pkg.typePos(enum.name), "type %s has no zero value, which proto3 requires", enum.name)
+		}
+		for i := range enumValues {
+			if enumValues[i].value != 0 {
+				values = append(values, &enumValues[i])
+			}
+		}
+
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "enum %s {\n", enum.name)
+		symbols := make(map[string]string)
+		for _, v := range values {
+			if !fitsInt32(v) {
+				g.pkg.errorf(v.pos, "value %s of constant %s is out of the int32 range of proto enums", v, v.originalName)
+			}
+			symbol := protoSymbol(prefix, v)
+			if prev, ok := symbols[symbol]; ok {
+				g.pkg.errorf(v.pos, "constants %s and %s have the same proto symbol %s", prev, v.originalName, symbol)
+			}
+			symbols[symbol] = v.originalName
+			fmt.Fprintf(b, "  %s = %s; // %s\n", symbol, v, v.cnName)
+		}
+		fmt.Fprintf(b, "}\n")
+	}
+	g.files[name] = b.Bytes()
+}
+
+// protoSymbol returns the proto enum value name of v, derived from its code,
+// or from the constant name if the code has no usable characters.
+func protoSymbol(prefix string, v *enumValue) string {
+	symbol := upperSnake(v.codeName)
+	if symbol == "" {
+		symbol = upperSnake(v.originalName)
+	}
+	if strings.HasPrefix(symbol, prefix+"_") {
+		return symbol
+	}
+	return prefix + "_" + symbol
+}
+
+// fitsInt32 reports whether v can be represented by a proto enum value.
+func fitsInt32(v *enumValue) bool {
+	if v.signed {
+		i := int64(v.value)
+		return math.MinInt32 <= i && i <= math.MaxInt32
+	}
+	return v.value <= math.MaxInt32
+}
+
+// upperSnake converts s to UPPER_SNAKE_CASE, splitting words at case changes
+// and at any character that isn't an ASCII letter or digit.
+func upperSnake(s string) string {
+	var b strings.Builder
+	pending := false // Whether a separator is due before the next character.
+	var prev rune
+	for _, r := range s {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			pending = b.Len() > 0
+			prev = 0
+			continue
+		}
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			pending = true
+		}
+		if pending {
+			b.WriteByte('_')
+			pending = false
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return b.String()
+}
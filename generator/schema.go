@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Formats of the SchemaFormat export.
+const (
+	SchemaJSONSchema = "jsonschema"
+	SchemaOpenAPI    = "openapi"
+)
+
+// schemaEnum is the schema of a generated type: a string holding one of the
+// codes if JSON encodes the values by code, or else an integer holding one
+// of the values.
+type schemaEnum struct {
+	Type         string        `json:"type"`
+	Enum         []interface{} `json:"enum"`
+	VarNames     []string      `json:"x-enum-varnames"`
+	Descriptions []string      `json:"x-enum-descriptions"`
+}
+
+// jsonSchemaDoc is a JSON Schema (draft 2020-12) document defining the types.
+type jsonSchemaDoc struct {
+	Schema string                 `json:"$schema"`
+	Defs   map[string]*schemaEnum `json:"$defs"`
+}
+
+// openAPIDoc is an OpenAPI 3 fragment defining the types as components.
+type openAPIDoc struct {
+	Components struct {
+		Schemas map[string]*schemaEnum `json:"schemas"`
+	} `json:"components"`
+}
+
+// writeSchema writes the schemas of the generated types to the named file,
+// either as a JSON Schema document or as OpenAPI 3 components. The schemas
+// are keyed by type name and the values sorted, so the output is stable.
+// The codes of the aliases are those of other values, so they are left out
+// of the string schemas.
+func (g *generator) writeSchema(name, format string) {
+	schemas := make(map[string]*schemaEnum, len(g.enums))
+	for _, enum := range g.enums {
+		schema := &schemaEnum{Type: "integer"}
+		values := enum.values
+		if enum.byCode {
+			schema.Type = "string"
+			values = enum.codeValues()
+		}
+		for i := range values {
+			v := &values[i]
+			if enum.byCode {
+				schema.Enum = append(schema.Enum, v.codeName)
+			} else {
+				schema.Enum = append(schema.Enum, json.Number(v.str))
+			}
+			schema.VarNames = append(schema.VarNames, v.originalName)
+			schema.Descriptions = append(schema.Descriptions, v.cnName)
+		}
+		schemas[enum.name] = schema
+	}
+
+	var doc interface{}
+	switch format {
+	case SchemaOpenAPI:
+		d := new(openAPIDoc)
+		d.Components.Schemas = schemas
+		doc = d
+	default:
+		doc = &jsonSchemaDoc{
+			Schema: "https://json-schema.org/draft/2020-12/schema",
+			Defs:   schemas,
+		}
+	}
+
+	b := new(bytes.Buffer)
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		fail(&Error{Msg: "writing schema", Err: err})
+	}
+	g.files[name] = b.Bytes()
+}
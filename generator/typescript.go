@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// writeTypeScript writes the generated types to the named TypeScript file.
+// Each type becomes a union type of its codes and a const record mapping the
+// codes to their value and name, so the aliases are left out. Types keep the
+// -type order and values are sorted, so the output is stable across runs.
+func (g *generator) writeTypeScript(name string) {
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, "// %s\n", g.generatedComment())
+	for _, enum := range g.enums {
+		values := enum.codeValues()
+		codes := make([]string, len(values))
+		for i := range values {
+			codes[i] = tsString(values[i].codeName)
+		}
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "export type %sCode = %s;\n", enum.name, strings.Join(codes, " | "))
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "export const %s = {\n", enum.name)
+		for i := range values {
+			v := &values[i]
+			fmt.Fprintf(b, "  %s: { value: %s, name: %s },\n", codes[i], v, tsString(v.cnName))
+		}
+		fmt.Fprintf(b, "} as const;\n")
+	}
+
+	g.files[name] = b.Bytes()
+}
+
+// tsString returns s as a TypeScript string literal.
+func tsString(s string) string {
+	b := new(bytes.Buffer)
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		fail(&Error{Msg: "internal error", Err: err})
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
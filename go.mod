@@ -3,6 +3,8 @@ module github.com/lixinio/lxstringer
 go 1.15
 
 require (
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.7.0
 	golang.org/x/tools v0.1.9
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,32 @@
+// Package lxenum holds the types shared by the code that lxstringer generates.
+package lxenum
+
+import "fmt"
+
+// UnknownCodeError is returned when a code is not declared for an enum type.
+type UnknownCodeError struct {
+	Type string // Name of the enum type.
+	Code string // The code that failed to parse.
+}
+
+func (e *UnknownCodeError) Error() string {
+	return fmt.Sprintf("unknown %s code %q", e.Type, e.Code)
+}
+
+// UnknownNameError is returned when a name is not declared for an enum type.
+type UnknownNameError struct {
+	Type string // Name of the enum type.
+	Name string // The name that failed to parse.
+}
+
+func (e *UnknownNameError) Error() string {
+	return fmt.Sprintf("unknown %s name %q", e.Type, e.Name)
+}
+
+// Option is a value of an enum type along with its code and name,
+// as listed by the generated <Type>Options functions.
+type Option struct {
+	Value int64  `json:"value"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+}
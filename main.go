@@ -67,46 +67,60 @@
 package main // import "golang.org/x/tools/cmd/stringer"
 
 import (
-	"bytes"
+	"context"
 	"flag"
 	"fmt"
-	"go/ast"
-	"go/constant"
-	"go/format"
-	"go/token"
-	"go/types"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
-	"regexp"
+	"runtime"
 	"sort"
 	"strings"
+	"sync"
 
-	"golang.org/x/tools/go/packages"
-)
-
-const (
-	DefCodeIndex  = "CodeIndex"
-	DefNameIndex  = "NameIndex"
-	DefCodeMap    = "CodeMap"
-	DefNameMap    = "NameMap"
-	DefCode2IDMap = "Code2IDMap"
-	DefCodeVal    = "CodeName"
-	DefNameVal    = "Name"
-	DefCodeFn     = "Code"
-	DefNameFn     = "Name"
-	DefCode2IDFn  = "CodeTo"
+	"github.com/lixinio/lxstringer/generator"
 )
 
 var (
-	typeNames     = flag.String("type", "", "comma-separated list of type names; must be set")
+	typeNames     = flag.String("type", "", "comma-separated list of type names; default the types annotated by "+generator.Directive)
+	configFile    = flag.String("config", "", "配置文件, 代替-type以及其他参数; 没有-type时默认使用包目录下的"+ConfigFile)
 	output        = flag.String("output", "", "output file name; default srcdir/<type>_string.go")
 	buildTags     = flag.String("tags", "", "comma-separated list of build tags to apply")
+	parallel      = flag.Int("p", runtime.GOMAXPROCS(0), "同时处理的包数量, 用于匹配多个包的模式, 例如./...")
+	check         = flag.Bool("check", false, "不写文件, 检查生成的文件是否最新; 打印差异, 有差异时以非0状态退出")
 	codeFnName    = flag.String("code", "Code", "code函数名")
 	nameFnName    = flag.String("name", "Name", "name函数名")
 	code2IDFnName = flag.String("code2id", "", "code转id函数名, 如果是`-`则不生成相关代码逻辑")
+	nameInFnName  = flag.String("namein", "NameIn", "按语言获取name的函数名, 如果是`-`则不生成")
+	locale        = flag.String("locale", "", "name函数对应的默认语言, 例如`zh`")
+	fallback      = flag.String("fallback", "", "NameIn找不到对应语言时依次尝试的语言, 逗号分隔, 例如`en,zh`")
+	metaFnName    = flag.String("meta", "Meta", "按key获取注释中`key=value`属性的函数名, 如果是`-`则不生成")
+	isValidFnName = flag.String("isvalid", "IsValid", "判断是否为已定义值的函数名, 如果是`-`则不生成(-sql和-null需要它)")
+	genParse      = flag.Bool("parse", false, "生成返回错误的Parse<Type>、Parse<Type>Name以及panic的MustParse<Type>, 依赖lxenum")
+	parseFnName   = flag.String("parsefn", "", "code转id并返回错误的函数名, 默认`Parse<Type>`, 如果是`-`则不生成(需要-parse)")
+	mustParseName = flag.String("mustparse", "", "code转id失败时panic的函数名, 默认`MustParse<Type>`, 如果是`-`则不生成(需要-parse)")
+	name2IDFnName = flag.String("nametoid", "", "name转id函数名, 默认`NameTo<Type>`, 如果是`-`则不生成")
+	parseNameFn   = flag.String("parsename", "", "name转id并返回错误的函数名, 默认`Parse<Type>Name`, 如果是`-`则不生成(需要-parse)")
 	skipCode      = flag.Bool("skipcode", false, "code转id函数名， 跳过code（code，name都取第一个字段）")
+	genJSON       = flag.Bool("json", false, "生成基于code的MarshalJSON/UnmarshalJSON")
+	genText       = flag.Bool("text", false, "生成基于code的MarshalText/UnmarshalText")
+	valuesOrder   = flag.String("values", "", "生成<Type>Values/<Type>Codes/<Type>Names, 按`value`(值)或`decl`(声明)排序")
+	genOptions    = flag.Bool("options", false, "生成<Type>Options, 返回{Value, Code, Name}列表, 注释中带`@hidden`的值会被隐藏")
+	bitmask       = flag.Bool("bitmask", false, "位掩码模式, 组合值的code/name由各个位的code/name拼接而成")
+	separator     = flag.String("sep", "|", "位掩码模式下拼接code/name的分隔符")
+	protoOutput   = flag.String("proto", "", "额外输出的.proto文件名, 为每个类型生成对应的enum")
+	protoPackage  = flag.String("protopkg", "", ".proto文件的package, 默认是Go的包名")
+	tsOutput      = flag.String("ts", "", "额外输出的TypeScript文件名, 包含每个类型的code联合类型以及code到{value, name}的映射")
+	schemaOutput  = flag.String("schema", "", "额外输出的JSON Schema/OpenAPI文件名")
+	schemaFormat  = flag.String("schemaformat", "jsonschema", "schema格式, jsonschema(draft 2020-12)或openapi(OpenAPI 3 components)")
+	docOutput     = flag.String("doc", "", "额外输出的文档文件名, 每个类型一个表格, 包含常量、值、code、name以及注释中的其他字段")
+	docFormat     = flag.String("docformat", "markdown", "文档格式, markdown或html(独立的html页面)")
+	gqlOutput     = flag.String("graphql", "", "额外输出的GraphQL schema文件名, 符号由code转换而来")
+	genGQL        = flag.Bool("gqlgen", false, "生成gqlgen的MarshalGQL/UnmarshalGQL, 使用与-graphql相同的符号")
+	pbTargets     = flag.String("pb", "", "生成ToPB/<Type>FromPB, 转换为protoc-gen-go生成的枚举, 如importpath.Type; 可按类型指定, 如T1=importpath.Type")
+	pbMatch       = flag.String("pbmatch", "code", "与protobuf枚举常量的匹配方式, code或name(常量名)")
+	genNull       = flag.Bool("null", false, "生成可空的Null<Type>类型, 隐含-sql(默认int), JSON与类型本身的编码一致")
+	sqlStorage    = flag.String("sql", "", "生成Scan/Value, 数据库存储形式`int`或`code`; 可按类型指定, 如`T1=int,T2=code`")
 )
 
 // Usage is a replacement usage function for the flags package.
@@ -114,6 +128,9 @@ func Usage() {
 	fmt.Fprintf(os.Stderr, "Usage of stringer:\n")
 	fmt.Fprintf(os.Stderr, "\tstringer [flags] -type T [directory]\n")
 	fmt.Fprintf(os.Stderr, "\tstringer [flags] -type T files... # Must be a single package\n")
+	fmt.Fprintf(os.Stderr, "\tstringer [flags] [-type T] packages... # Such as ./..., one output per package\n")
+	fmt.Fprintf(os.Stderr, "\tstringer -config file # Or %s in the directory without -type\n", ConfigFile)
+	fmt.Fprintf(os.Stderr, "\tstringer [flags] [directory] # Types annotated by %s\n", generator.Directive)
 	fmt.Fprintf(os.Stderr, "For more information, see:\n")
 	fmt.Fprintf(os.Stderr, "\thttps://pkg.go.dev/golang.org/x/tools/cmd/stringer\n")
 	fmt.Fprintf(os.Stderr, "Flags:\n")
@@ -125,11 +142,6 @@ func main() {
 	log.SetPrefix("stringer: ")
 	flag.Usage = Usage
 	flag.Parse()
-	if len(*typeNames) == 0 {
-		flag.Usage()
-		os.Exit(2)
-	}
-	types := strings.Split(*typeNames, ",")
 	var tags []string
 	if len(*buildTags) > 0 {
 		tags = strings.Split(*buildTags, ",")
@@ -142,680 +154,237 @@ func main() {
 		args = []string{"."}
 	}
 
-	// Parse the package once.
-	var dir string
-	g := Generator{
-		codeFnName:    *codeFnName,
-		nameFnName:    *nameFnName,
-		code2IDFnName: *code2IDFnName,
-		skipCode:      *skipCode,
-	}
-	g.codeFnName = *codeFnName
-	if g.codeFnName == "" {
-		g.codeFnName = DefCodeFn
-	}
-	g.nameFnName = *nameFnName
-	if g.nameFnName == "" {
-		g.nameFnName = DefNameFn
-	}
+	ctx := context.Background()
+	out := &Outputs{Check: *check, Diff: os.Stdout}
 
-	// TODO(suzmue): accept other patterns for packages (directories, list of files, import paths, etc).
-	if len(args) == 1 && isDirectory(args[0]) {
-		dir = args[0]
-	} else {
-		if len(tags) != 0 {
-			log.Fatal("-tags option applies only to directories, not when files are specified")
+	// Without -type, look for the config file in the package directory.
+	configName := *configFile
+	if configName == "" && len(*typeNames) == 0 && len(args) == 1 {
+		if _, err := os.Stat(filepath.Join(args[0], ConfigFile)); err == nil {
+			configName = filepath.Join(args[0], ConfigFile)
 		}
-		dir = filepath.Dir(args[0])
-	}
-
-	g.parsePackage(args, tags)
-
-	// Print the header and package clause.
-	g.Printf("// Code generated by \"stringer %s\"; DO NOT EDIT.\n", strings.Join(os.Args[1:], " "))
-	g.Printf("\n")
-	g.Printf("package %s", g.pkg.name)
-	g.Printf("\n")
-	g.Printf("import \"strconv\"\n") // Used by all methods.
-
-	// Run generate for each type.
-	for _, typeName := range types {
-		g.generate(typeName)
-		g.Printf("\n")
-	}
-
-	// Format the output.
-	src := g.format()
-
-	// Write to file.
-	outputName := *output
-	if outputName == "" {
-		baseName := fmt.Sprintf("%s_string.go", types[0])
-		outputName = filepath.Join(dir, strings.ToLower(baseName))
-	}
-	err := ioutil.WriteFile(outputName, src, 0644)
-	if err != nil {
-		log.Fatalf("writing output: %s", err)
-	}
-}
-
-// isDirectory reports whether the named file is a directory.
-func isDirectory(name string) bool {
-	info, err := os.Stat(name)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return info.IsDir()
-}
-
-// Generator holds the state of the analysis. Primarily used to buffer
-// the output for format.Source.
-type Generator struct {
-	buf bytes.Buffer // Accumulated output.
-	pkg *Package     // Package we are scanning.
-
-	codeFnName    string
-	nameFnName    string
-	code2IDFnName string
-	skipCode      bool
-}
-
-func (g *Generator) Printf(format string, args ...interface{}) {
-	fmt.Fprintf(&g.buf, format, args...)
-}
-
-// File holds a single parsed file and associated data.
-type File struct {
-	pkg  *Package  // Package to which this file belongs.
-	file *ast.File // Parsed AST.
-	// These fields are reset for each type being generated.
-	typeName string  // Name of the constant type.
-	values   []Value // Accumulator for constant values of that type.
-	skipCode bool
-}
-
-type Package struct {
-	name  string
-	defs  map[*ast.Ident]types.Object
-	files []*File
-}
-
-// parsePackage analyzes the single package constructed from the patterns and tags.
-// parsePackage exits if there is an error.
-func (g *Generator) parsePackage(patterns []string, tags []string) {
-	cfg := &packages.Config{
-		Mode: packages.LoadSyntax,
-		// TODO: Need to think about constants in test files. Maybe write type_string_test.go
-		// in a separate pass? For later.
-		Tests:      false,
-		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(tags, " "))},
-	}
-	pkgs, err := packages.Load(cfg, patterns...)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if len(pkgs) != 1 {
-		log.Fatalf("error: %d packages found", len(pkgs))
 	}
-	g.addPackage(pkgs[0])
-}
-
-// addPackage adds a type checked Package and its syntax files to the generator.
-func (g *Generator) addPackage(pkg *packages.Package) {
-	g.pkg = &Package{
-		name:  pkg.Name,
-		defs:  pkg.TypesInfo.Defs,
-		files: make([]*File, len(pkg.Syntax)),
-	}
-
-	for i, file := range pkg.Syntax {
-		g.pkg.files[i] = &File{
-			file:     file,
-			pkg:      g.pkg,
-			skipCode: g.skipCode,
+	if configName != "" {
+		// The config file holds all the generation options.
+		var set []string
+		flag.Visit(func(f *flag.Flag) {
+			if _, ok := runFlags[f.Name]; !ok && f.Name != "config" {
+				set = append(set, "-"+f.Name)
+			}
+		})
+		if len(set) != 0 {
+			log.Fatalf("%s can't be used with the config file %s, set the options in it instead", strings.Join(set, ", "), configName)
 		}
-	}
-}
-
-// generate produces the String method for the named type.
-func (g *Generator) generate(typeName string) {
-	values := make([]Value, 0, 100)
-	for _, file := range g.pkg.files {
-		// Set the state for this run of the walker.
-		file.typeName = typeName
-		file.values = nil
-		if file.file != nil {
-			ast.Inspect(file.file, file.genDecl)
-			values = append(values, file.values...)
+		files, err := generator.Generate(ctx, loadConfig(configName).generatorConfig())
+		if err != nil {
+			fatal(err)
 		}
+		out.writeAll(files)
+		out.exit()
+		return
 	}
 
-	if len(values) == 0 {
-		log.Fatalf("no values defined for type %s", typeName)
-	}
-	// Generate code that will fail if the constants change value.
-	g.Printf("func _() {\n")
-	g.Printf("\t// An \"invalid array index\" compiler error signifies that the constant values have changed.\n")
-	g.Printf("\t// Re-run the stringer command to generate them again.\n")
-	g.Printf("\tvar x [1]struct{}\n")
-	for _, v := range values {
-		g.Printf("\t_ = x[%s - %s]\n", v.originalName, v.str)
-	}
-	g.Printf("}\n")
-	runs := splitIntoRuns(values)
-	// The decision of which pattern to use depends on the number of
-	// runs in the numbers. If there's only one, it's easy. For more than
-	// one, there's a tradeoff between complexity and size of the data
-	// and code vs. the simplicity of a map. A map takes more space,
-	// but so does the code. The decision here (crossover at 10) is
-	// arbitrary, but considers that for large numbers of runs the cost
-	// of the linear scan in the switch might become important, and
-	// rather than use yet another algorithm such as binary search,
-	// we punt and use a map. In any case, the likelihood of a map
-	// being necessary for any realistic example other than bitmasks
-	// is very low. And bitmasks probably deserve their own analysis,
-	// to be done some other day.
-	switch {
-	case len(runs) == 1:
-		g.buildOneRun(runs, typeName)
-		g.code2ID(runs, typeName)
-	case len(runs) <= 10:
-		g.buildMultipleRuns(runs, typeName)
-		g.code2ID2(runs, typeName)
-	default:
-		g.buildMap(runs, typeName)
-		g.code2ID(runs, typeName)
-	}
-}
+	cfg, checkTypes := flagConfig(args, tags)
 
-// splitIntoRuns breaks the values into runs of contiguous sequences.
-// For example, given 1,2,3,5,6,7 it returns {1,2,3},{5,6,7}.
-// The input slice is known to be non-empty.
-func splitIntoRuns(values []Value) [][]Value {
-	// We use stable sort so the lexically first name is chosen for equal elements.
-	sort.Stable(byValue(values))
-	// Remove duplicates. Stable sort has put the one we want to print first,
-	// so use that one. The String method won't care about which named constant
-	// was the argument, so the first name for the given value is the only one to keep.
-	// We need to do this because identical values would cause the switch or map
-	// to fail to compile.
-	j := 1
-	for i := 1; i < len(values); i++ {
-		if values[i].value != values[i-1].value {
-			values[j] = values[i]
-			j++
+	// Patterns such as ./... may match several packages, each generated on its own.
+	dirs := listPackages(args, tags)
+	if len(dirs) > 1 {
+		if *output != "" {
+			log.Fatal("-output applies only to a single package")
 		}
-	}
-	values = values[:j]
-	runs := make([][]Value, 0, 10)
-	for len(values) > 0 {
-		// One contiguous sequence per outer loop.
-		i := 1
-		for i < len(values) && values[i].value == values[i-1].value+1 {
-			i++
+		if *protoOutput != "" || *tsOutput != "" || *schemaOutput != "" || *gqlOutput != "" || *docOutput != "" {
+			log.Fatal("-proto, -ts, -schema, -graphql and -doc apply only to a single package")
+		}
+		generated, err := runPackages(ctx, dirs, cfg, out, *parallel)
+		if err != nil {
+			fatal(err)
 		}
-		runs = append(runs, values[:i])
-		values = values[i:]
+		if generated == 0 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		checkTypes()
+		out.exit()
+		return
 	}
-	return runs
-}
 
-// format returns the gofmt-ed contents of the Generator's buffer.
-func (g *Generator) format() []byte {
-	src, err := format.Source(g.buf.Bytes())
-	if err != nil {
-		// Should never happen, but can arise when developing this code.
-		// The user can compile the output to see the error.
-		log.Printf("warning: internal error: invalid Go generated: %s", err)
-		log.Printf("warning: compile the package to analyze the error")
-		return g.buf.Bytes()
+	cfg.Patterns = packagePatterns(args, dirs)
+	files, err := generator.Generate(ctx, cfg)
+	if err == generator.ErrNoTypes {
+		flag.Usage()
+		os.Exit(2)
 	}
-	return src
-}
-
-// Value represents a declared constant.
-type Value struct {
-	originalName string // The name of the constant.
-	codeName     string // The name with trimmed prefix.
-	cnName       string
-	// The value is stored as a bit pattern alone. The boolean tells us
-	// whether to interpret it as an int64 or a uint64; the only place
-	// this matters is when sorting.
-	// Much of the time the str field is all we need; it is printed
-	// by Value.String.
-	value  uint64 // Will be converted to int64 when needed.
-	signed bool   // Whether the constant is a signed type.
-	str    string // The string representation given by the "go/constant" package.
-}
-
-func (v *Value) String() string {
-	return v.str
-}
-
-func ValueCode(v *Value) string {
-	return v.codeName
-}
-
-func ValueName(v *Value) string {
-	return v.cnName
-}
-
-// byValue lets us sort the constants into increasing order.
-// We take care in the Less method to sort in signed or unsigned order,
-// as appropriate.
-type byValue []Value
-
-func (b byValue) Len() int      { return len(b) }
-func (b byValue) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
-func (b byValue) Less(i, j int) bool {
-	if b[i].signed {
-		return int64(b[i].value) < int64(b[j].value)
+	if err != nil {
+		fatal(err)
 	}
-	return b[i].value < b[j].value
+	checkTypes()
+	out.writeAll(files)
+	out.exit()
 }
 
-// genDecl processes one declaration clause.
-func (f *File) genDecl(node ast.Node) bool {
-	decl, ok := node.(*ast.GenDecl)
-	if !ok || decl.Tok != token.CONST {
-		// We only care about const declarations.
-		return true
+// fatal reports the error of a generation and exits. Each problem of an
+// ErrorList is printed on its own line as file:line:col: message, with the
+// file relative to the current directory, for editors and CI to pick up.
+func fatal(err error) {
+	list, ok := err.(generator.ErrorList)
+	if !ok {
+		log.Fatal(err)
 	}
-	// The name of the type of the constants we are declaring.
-	// Can change if this is a multi-element declaration.
-	typ := ""
-	// Loop over the elements of the declaration. Each element is a ValueSpec:
-	// a list of names possibly followed by a type, possibly followed by values.
-	// If the type and value are both missing, we carry down the type (and value,
-	// but the "go/types" package takes care of that).
-	for _, spec := range decl.Specs {
-		vspec := spec.(*ast.ValueSpec) // Guaranteed to succeed as this is CONST.
-		if vspec.Type == nil && len(vspec.Values) > 0 {
-			// "X = 1". With no type but a value. If the constant is untyped,
-			// skip this vspec and reset the remembered type.
-			typ = ""
-
-			// If this is a simple type conversion, remember the type.
-			// We don't mind if this is actually a call; a qualified call won't
-			// be matched (that will be SelectorExpr, not Ident), and only unusual
-			// situations will result in a function call that appears to be
-			// a type conversion.
-			ce, ok := vspec.Values[0].(*ast.CallExpr)
-			if !ok {
-				continue
-			}
-			id, ok := ce.Fun.(*ast.Ident)
-			if !ok {
-				continue
-			}
-			typ = id.Name
+	wd, _ := os.Getwd()
+	for _, e := range list {
+		if rel, err := filepath.Rel(wd, e.Pos.Filename); err == nil && wd != "" && !strings.HasPrefix(rel, "..") {
+			e.Pos.Filename = rel
 		}
-		if vspec.Type != nil {
-			// "X T". We have a type. Remember it.
-			ident, ok := vspec.Type.(*ast.Ident)
-			if !ok {
-				continue
-			}
-			typ = ident.Name
-		}
-		if typ != f.typeName {
-			// This is not the type we're looking for.
-			continue
+		fmt.Fprintln(os.Stderr, e)
+	}
+	os.Exit(1)
+}
+
+// flagConfig returns the generator config the flags ask for. The -type list
+// shares a file, while each type annotated by a directive gets its own unless
+// -output is set. The returned function, called once the types are generated,
+// exits if -sql or -pb names a type that was not.
+func flagConfig(patterns, tags []string) (generator.Config, func()) {
+	opts := generator.Options{
+		CodeFnName:    *codeFnName,
+		NameFnName:    *nameFnName,
+		Code2IDFnName: *code2IDFnName,
+		IsValidFnName: *isValidFnName,
+		MetaFnName:    *metaFnName,
+		NameInFnName:  *nameInFnName,
+		Locale:        *locale,
+		GenParse:      *genParse,
+		ParseFnName:   *parseFnName,
+		MustParseName: *mustParseName,
+		Name2IDFnName: *name2IDFnName,
+		ParseNameFn:   *parseNameFn,
+		SkipCode:      *skipCode,
+		GenJSON:       *genJSON,
+		GenText:       *genText,
+		GenNull:       *genNull,
+		ValuesOrder:   *valuesOrder,
+		GenOptions:    *genOptions,
+		PBMatch:       *pbMatch,
+		GenGQL:        *genGQL,
+		Bitmask:       *bitmask,
+		Separator:     *separator,
+	}
+	if len(*fallback) > 0 {
+		opts.Fallback = strings.Split(*fallback, ",")
+	}
+	sqlStorages := parseTypeOptions(*sqlStorage)
+	pbTargets := parseTypeOptions(*pbTargets)
+	var mu sync.Mutex // Guards used, as packages are generated concurrently.
+	used := make(map[string]bool)
+	optionsOf := func(typeName string) generator.Options {
+		mu.Lock()
+		used[typeName] = true
+		mu.Unlock()
+		o := opts
+		o.SQLStorage = typeOption(sqlStorages, typeName)
+		o.PBTarget = typeOption(pbTargets, typeName)
+		return o
+	}
+
+	cfg := generator.Config{
+		Patterns: patterns,
+		Tags:     tags,
+		Args:     generatedArgs(os.Args[1:]),
+		Options:  optionsOf,
+		Output:   *output,
+		Exports: generator.Exports{
+			Proto:        *protoOutput,
+			ProtoPkg:     *protoPackage,
+			TS:           *tsOutput,
+			Schema:       *schemaOutput,
+			SchemaFormat: *schemaFormat,
+			GraphQL:      *gqlOutput,
+			Doc:          *docOutput,
+			DocFormat:    *docFormat,
+		},
+	}
+	if len(*typeNames) != 0 {
+		for _, typeName := range strings.Split(*typeNames, ",") {
+			cfg.Types = append(cfg.Types, generator.TypeOptions{Name: typeName, Options: optionsOf(typeName)})
 		}
-		// We now have a list of names (from one line of source code) all being
-		// declared with the desired type.
-		// Grab their names and actual values and store them in f.values.
-		for _, name := range vspec.Names {
-			if name.Name == "_" {
-				continue
-			}
-			// This dance lets the type checker find the values for us. It's a
-			// bit tricky: look up the object declared by the name, find its
-			// types.Const, and extract its value.
-			obj, ok := f.pkg.defs[name]
-			if !ok {
-				log.Fatalf("no value for constant %s", name)
-			}
-			info := obj.Type().Underlying().(*types.Basic).Info()
-			if info&types.IsInteger == 0 {
-				log.Fatalf("can't handle non-integer constant type %s", typ)
-			}
-			value := obj.(*types.Const).Val() // Guaranteed to succeed as this is CONST.
-			if value.Kind() != constant.Int {
-				log.Fatalf("can't happen: constant is not an integer %s", name)
-			}
-			i64, isInt := constant.Int64Val(value)
-			u64, isUint := constant.Uint64Val(value)
-			if !isInt && !isUint {
-				log.Fatalf("internal error: value of %s is not an integer: %s", name, value.String())
-			}
-			if !isInt {
-				u64 = uint64(i64)
-			}
-			v := Value{
-				originalName: name.Name,
-				value:        u64,
-				signed:       info&types.IsUnsigned == 0,
-				str:          value.String(),
-			}
-			if c := vspec.Comment; c != nil && len(c.List) == 1 {
-				r := regexp.MustCompile(`[^\s"]+|"([^"]*)"`)
-				names := r.FindAllString(strings.TrimSpace(c.Text()), -1)
-				if len(names) > 0 {
-					v.codeName = strings.Trim(names[0], "\"")
-					if f.skipCode {
-						v.cnName = v.codeName
-					}
-				}
-				if !f.skipCode && len(names) > 1 {
-					v.cnName = strings.Trim(names[1], "\"")
+	}
+	checkTypes := func() {
+		for _, f := range []struct {
+			name    string
+			options map[string]string
+		}{{"sql", sqlStorages}, {"pb", pbTargets}} {
+			var unknown []string
+			for typeName := range f.options {
+				if typeName != "" && !used[typeName] {
+					unknown = append(unknown, typeName)
 				}
 			}
-			if v.cnName == "" {
-				v.cnName = v.originalName
-			}
-			if v.codeName == "" {
-				v.codeName = v.originalName
+			if len(unknown) > 0 {
+				sort.Strings(unknown)
+				log.Fatalf("-%s names types that are not generated: %s", f.name, strings.Join(unknown, ", "))
 			}
-			f.values = append(f.values, v)
 		}
 	}
-	return false
+	return cfg, checkTypes
 }
 
-// Helpers
-
-// usize returns the number of bits of the smallest unsigned integer
-// type that will hold n. Used to create the smallest possible slice of
-// integers to use as indexes into the concatenated strings.
-func usize(n int) int {
-	switch {
-	case n < 1<<8:
-		return 8
-	case n < 1<<16:
-		return 16
-	default:
-		// 2^32 is enough constants for anyone.
-		return 32
+// parseTypeOptions parses a flag that is either a single option for all types
+// or a comma-separated list of type=option pairs. The option for all types is
+// stored under "".
+func parseTypeOptions(s string) map[string]string {
+	options := make(map[string]string)
+	if s == "" {
+		return options
 	}
-}
-
-// declareIndexAndNameVars declares the index slices and concatenated names
-// strings representing the runs of values.
-func (g *Generator) declareIndexAndNameVars(runs [][]Value, typeName string) {
-	var indexes, names []string
-	for i, run := range runs {
-		indexs, namex := g.createIndexAndNameDecl(run, typeName, fmt.Sprintf("_%d", i))
-		if len(run) != 1 {
-			indexes = append(indexes, indexs[0], indexs[1])
+	for _, item := range strings.Split(s, ",") {
+		typeName, option := "", item
+		if i := strings.Index(item, "="); i >= 0 {
+			typeName, option = item[:i], item[i+1:]
 		}
-		names = append(names, namex[0], namex[1])
-	}
-	g.Printf("const (\n")
-	for _, name := range names {
-		g.Printf("\t%s\n", name)
-	}
-	g.Printf(")\n\n")
-
-	if len(indexes) > 0 {
-		g.Printf("var (")
-		for _, index := range indexes {
-			g.Printf("\t%s\n", index)
-		}
-		g.Printf(")\n\n")
-	}
-}
-
-// declareIndexAndNameVar is the single-run version of declareIndexAndNameVars
-func (g *Generator) declareIndexAndNameVar(run []Value, typeName string) {
-	indexs, names := g.createIndexAndNameDecl(run, typeName, "")
-	g.Printf("const (\n")
-	g.Printf("%s\n", names[0])
-	g.Printf("%s\n", names[1])
-	g.Printf(")\n\n")
-
-	g.Printf("var (\n")
-	g.Printf("%s\n", indexs[0])
-	g.Printf("%s\n", indexs[1])
-	g.Printf(")\n\n")
-}
-
-// createIndexAndNameDecl returns the pair of declarations for the run. The caller will add "const" and "var".
-func (g *Generator) createIndexAndNameDecl(
-	run []Value,
-	typeName string,
-	suffix string,
-) ([2]string, [2]string) {
-	f := func(nameKey, indexKey string, fn func(*Value) string) (string, string) {
-		b := new(bytes.Buffer)
-		indexes := make([]int, len(run))
-		for i := range run {
-			b.WriteString(fn(&run[i]))
-			indexes[i] = b.Len()
-		}
-		nameConst := fmt.Sprintf("_%s%s%s = %q", typeName, nameKey, suffix, b.String())
-		nameLen := b.Len()
-
-		b.Reset()
-		fmt.Fprintf(b, "_%s%s%s = [...]uint%d{0, ", typeName, indexKey, suffix, usize(nameLen))
-		for i, v := range indexes {
-			if i > 0 {
-				fmt.Fprintf(b, ", ")
-			}
-			fmt.Fprintf(b, "%d", v)
-		}
-		fmt.Fprintf(b, "}")
-		return b.String(), nameConst
-	}
-
-	idx1, name1 := f(DefCodeVal, DefCodeIndex, ValueCode)
-	idx2, name2 := f(DefNameVal, DefNameIndex, ValueName)
-	return [2]string{idx1, idx2}, [2]string{name1, name2}
-}
-
-// declareNameVars declares the concatenated names string representing all the values in the runs.
-func (g *Generator) declareNameVars(runs [][]Value, typeName string, suffix string) {
-	g.Printf("const (\n")
-	f := func(nameKey string, fn func(*Value) string) {
-		g.Printf("\t_%s%s%s = \"", typeName, nameKey, suffix)
-		for _, run := range runs {
-			for i := range run {
-				g.Printf("%s", fn(&run[i]))
-			}
-		}
-		g.Printf("\"\n")
-	}
-	f(DefCodeVal, ValueCode)
-	f(DefNameVal, ValueName)
-	g.Printf(")\n")
-}
-
-// buildOneRun generates the variables and String method for a single run of contiguous values.
-func (g *Generator) buildOneRun(runs [][]Value, typeName string) {
-	values := runs[0]
-	g.Printf("\n")
-	g.declareIndexAndNameVar(values, typeName)
-	// The generated code is simple enough to write as a Printf format.
-	lessThanZero := ""
-	if values[0].signed {
-		lessThanZero = "i < 0 || "
-	}
-	if values[0].value == 0 { // Signed or unsigned, 0 is still 0.
-		g.Printf(
-			stringOneRun, typeName, usize(len(values)), lessThanZero,
-			g.codeFnName, DefCodeVal, DefCodeIndex,
-		)
-		g.Printf("\n")
-		g.Printf(
-			stringOneRun, typeName, usize(len(values)), lessThanZero,
-			g.nameFnName, DefNameVal, DefNameIndex,
-		)
-	} else {
-		g.Printf(
-			stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)),
-			lessThanZero, g.codeFnName, DefCodeVal, DefCodeIndex,
-		)
-		g.Printf("\n")
-		g.Printf(
-			stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)),
-			lessThanZero, g.nameFnName, DefNameVal, DefNameIndex,
-		)
+		options[typeName] = option
 	}
+	return options
 }
 
-// Arguments to format are:
-//	[1]: type name
-//	[2]: size of index element (8 for uint8 etc.)
-//	[3]: less than zero check (for signed types)
-const stringOneRun = `func (i %[1]s) %[4]s() string {
-	if %[3]si >= %[1]s(len(_%[1]s%[6]s)-1) {
-		return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+// typeOption returns the option of the named type parsed by parseTypeOptions,
+// or the option for all types if it has none.
+func typeOption(options map[string]string, typeName string) string {
+	if option, ok := options[typeName]; ok {
+		return option
 	}
-	return _%[1]s%[5]s[_%[1]s%[6]s[i]:_%[1]s%[6]s[i+1]]
+	return options[""]
 }
-`
 
-// Arguments to format are:
-//	[1]: type name
-//	[2]: lowest defined value for type, as a string
-//	[3]: size of index element (8 for uint8 etc.)
-//	[4]: less than zero check (for signed types)
-/*
- */
-const stringOneRunWithOffset = `func (i %[1]s) %[5]s() string {
-	i -= %[2]s
-	if %[4]si >= %[1]s(len(_%[1]s%[7]s)-1) {
-		return "%[1]s(" + strconv.FormatInt(int64(i + %[2]s), 10) + ")"
-	}
-	return _%[1]s%[6]s[_%[1]s%[7]s[i] : _%[1]s%[7]s[i+1]]
+// runFlags are the flags that change how stringer runs but not what it
+// generates, by whether they take a value. They are left out of the
+// generated comment so that, for example, -check compares against the files
+// generated without it.
+var runFlags = map[string]bool{
+	"check": false,
+	"p":     true,
 }
-`
-
-// buildMultipleRuns generates the variables and String method for multiple runs of contiguous values.
-// For this pattern, a single Printf format won't do.
-func (g *Generator) buildMultipleRuns(runs [][]Value, typeName string) {
-	g.Printf("\n")
-	g.declareIndexAndNameVars(runs, typeName)
 
-	f := func(funcName, nameKey, indexKey string) {
-		g.Printf("func (i %s) %s() string {\n", typeName, funcName)
-		g.Printf("\tswitch {\n")
-		for i, values := range runs {
-			if len(values) == 1 {
-				g.Printf("\tcase i == %s:\n", &values[0])
-				g.Printf("\t\treturn _%s%s_%d\n", typeName, nameKey, i)
-				continue
-			}
-			if values[0].value == 0 && !values[0].signed {
-				// For an unsigned lower bound of 0, "0 <= i" would be redundant.
-				g.Printf("\tcase i <= %s:\n", &values[len(values)-1])
-			} else {
-				g.Printf("\tcase %s <= i && i <= %s:\n", &values[0], &values[len(values)-1])
-			}
-			if values[0].value != 0 {
-				g.Printf("\t\ti -= %s\n", &values[0])
-			}
-			g.Printf(
-				"\t\treturn _%s%s_%d[_%s%s_%d[i]:_%s%s_%d[i+1]]\n",
-				typeName, nameKey, i, typeName, indexKey, i, typeName, indexKey, i,
-			)
+// generatedArgs returns the command line arguments without the run flags.
+func generatedArgs(args []string) []string {
+	var kept []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			return append(kept, args[i:]...)
 		}
-		g.Printf("\tdefault:\n")
-		g.Printf("\t\treturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n", typeName)
-		g.Printf("\t}\n")
-		g.Printf("}\n")
-	}
-	f(g.codeFnName, DefCodeVal, DefCodeIndex)
-	g.Printf("\n")
-	f(g.nameFnName, DefNameVal, DefNameIndex)
-}
-
-// buildMap handles the case where the space is so sparse a map is a reasonable fallback.
-// It's a rare situation but has simple code.
-func (g *Generator) buildMap(runs [][]Value, typeName string) {
-	g.Printf("\n")
-	g.declareNameVars(runs, typeName, "")
-	f := func(mapName, nameKey string, fn func(*Value) string) {
-		g.Printf("\nvar _%s%s = map[%s]string{\n", typeName, mapName, typeName)
-		n := 0
-		for _, values := range runs {
-			for _, value := range values {
-				g.Printf("\t%s: _%s%s[%d:%d],\n", &value, typeName, nameKey, n, n+len(fn(&value)))
-				n += len(fn(&value))
-			}
+		name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		hasValue := strings.Contains(name, "=")
+		if hasValue {
+			name = name[:strings.Index(name, "=")]
 		}
-		g.Printf("}\n\n")
-	}
-	f(DefCodeMap, DefCodeVal, ValueCode)
-	f(DefNameMap, DefNameVal, ValueName)
-	g.Printf(stringMap, typeName, g.codeFnName, DefCodeMap)
-	g.Printf("\n")
-	g.Printf(stringMap, typeName, g.nameFnName, DefNameMap)
-}
-
-// Argument to format is the type name.
-const stringMap = `func (i %[1]s) %[2]s() string {
-	if str, ok := _%[1]s%[3]s[i]; ok {
-		return str
-	}
-	return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
-}
-`
-
-func (g *Generator) code2ID(runs [][]Value, typeName string) {
-	if g.code2IDFnName == "-" {
-		return
-	}
-
-	g.Printf("\n")
-	g.Printf("\nvar _%s%s = map[string]%s{\n", typeName, DefCode2IDMap, typeName)
-	n := 0
-	for _, values := range runs {
-		for _, value := range values {
-			g.Printf("\t_%s%s[%d:%d]: %s,\n", typeName, DefCodeVal, n, n+len(ValueCode(&value)), &value)
-			n += len(ValueCode(&value))
+		takesValue, ok := runFlags[name]
+		if !ok {
+			kept = append(kept, arg)
+			continue
 		}
-	}
-
-	fnName := g.code2IDFnName
-	if fnName == "" {
-		fnName = fmt.Sprintf("%s%s", DefCode2IDFn, typeName)
-	}
-
-	g.Printf("}\n\n")
-	g.Printf(stringCode2IDMap, typeName, fnName, DefCode2IDMap)
-	g.Printf("\n")
-}
-
-func (g *Generator) code2ID2(runs [][]Value, typeName string) {
-	if g.code2IDFnName == "-" {
-		return
-	}
-
-	g.Printf("\n")
-	g.Printf("\nvar _%s%s = map[string]%s{\n", typeName, DefCode2IDMap, typeName)
-	n := 0
-	for i, values := range runs {
-		for _, value := range values {
-			g.Printf("\t_%s%s_%d: %s,\n", typeName, DefCodeVal, i, &value)
-			n += len(ValueCode(&value))
+		if takesValue && !hasValue {
+			i++ // Skip the value as well.
 		}
 	}
-
-	fnName := g.code2IDFnName
-	if fnName == "" {
-		fnName = fmt.Sprintf("%s%s", DefCode2IDFn, typeName)
-	}
-
-	g.Printf("}\n\n")
-	g.Printf(stringCode2IDMap, typeName, fnName, DefCode2IDMap)
-	g.Printf("\n")
-}
-
-const stringCode2IDMap = `func %[2]s(code string, dftVal %[1]s) %[1]s {
-	if val, ok := _%[1]s%[3]s[code]; ok {
-		return val
-	}
-	return dftVal
+	return kept
 }
-`
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lixinio/lxstringer/generator"
+	"golang.org/x/tools/go/packages"
+)
+
+// listPackages returns the directories of the packages matched by the
+// patterns, without type checking them. Directories under the current one
+// are relative to it.
+func listPackages(patterns []string, tags []string) []string {
+	cfg := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles,
+		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(tags, " "))},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var dirs []string
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) == 0 {
+			continue
+		}
+		dir := filepath.Dir(pkg.GoFiles[0])
+		if rel, err := filepath.Rel(wd, dir); err == nil && !strings.HasPrefix(rel, "..") {
+			// A relative directory needs a leading "./" so it is not taken for an import path.
+			dir = "." + string(filepath.Separator) + rel
+			if rel == "." {
+				dir = rel
+			}
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// packagePatterns returns the patterns naming the single package matched by
+// patterns, given its directories. Generate only takes a directory or files,
+// so patterns such as ./... or import paths are replaced by the directory.
+func packagePatterns(patterns, dirs []string) []string {
+	if len(dirs) != 1 {
+		return patterns
+	}
+	for _, pattern := range patterns {
+		if _, err := os.Stat(pattern); err != nil {
+			return dirs
+		}
+	}
+	return patterns
+}
+
+// runPackages generates the types of the config in each package directory,
+// type checking up to workers packages at a time. It returns the number of
+// packages that had types to generate, or the problems of all the packages
+// as an ErrorList if there are any. Each type given by the config has to be
+// declared by one of the packages at least.
+func runPackages(ctx context.Context, dirs []string, cfg generator.Config, out *Outputs, workers int) (int, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	var mu sync.Mutex
+	generated := 0
+	var errs generator.ErrorList
+	declared := make(map[string]bool)
+	work := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for dir := range work {
+				c := cfg
+				c.Patterns = []string{dir}
+				c.SkipMissing = true
+				c.Declared = func(typeName string) {
+					mu.Lock()
+					declared[typeName] = true
+					mu.Unlock()
+				}
+				files, err := generator.Generate(ctx, c)
+				if err == generator.ErrNoTypes {
+					continue
+				}
+				if err != nil {
+					mu.Lock()
+					errs = appendErrors(errs, err)
+					mu.Unlock()
+					continue
+				}
+				out.writeAll(files)
+				mu.Lock()
+				generated++
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, dir := range dirs {
+		work <- dir
+	}
+	close(work)
+	wg.Wait()
+	if len(errs) > 0 {
+		errs.Sort()
+		return 0, errs
+	}
+	for _, t := range cfg.Types {
+		if !declared[t.Name] {
+			errs = append(errs, &generator.Error{Msg: fmt.Sprintf("no package declares type %s", t.Name)})
+		}
+	}
+	if len(errs) > 0 {
+		return 0, errs
+	}
+	return generated, nil
+}
+
+// appendErrors appends the problems of an error returned by Generate.
+func appendErrors(list generator.ErrorList, err error) generator.ErrorList {
+	switch err := err.(type) {
+	case generator.ErrorList:
+		return append(list, err...)
+	case *generator.Error:
+		return append(list, err)
+	}
+	return append(list, &generator.Error{Msg: err.Error()})
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixinio/lxstringer/generator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPackages(t *testing.T) {
+	dirs := listPackages([]string{"./testdata/multi/a", "./testdata/multi/b"}, nil)
+	require.Equal(t, dirs, []string{
+		filepath.FromSlash("./testdata/multi/a"),
+		filepath.FromSlash("./testdata/multi/b"),
+	})
+
+	// Only a declares Color: b is skipped rather than failing with ErrNoTypes.
+	diff := new(bytes.Buffer)
+	out := &Outputs{Check: true, Diff: diff}
+	cfg := generator.Config{Types: []generator.TypeOptions{{Name: "Color"}}}
+	generated, err := runPackages(context.Background(), dirs, cfg, out, 2)
+	require.Nil(t, err)
+	require.Equal(t, generated, 1)
+	require.Equal(t, out.Stale, []string{filepath.Join("testdata", "multi", "a", "color_string.go")})
+	require.Contains(t, diff.String(), "+func (i Color) Code() string {")
+
+	// Without -type, neither package has annotated types.
+	out = &Outputs{Check: true, Diff: new(bytes.Buffer)}
+	generated, err = runPackages(context.Background(), dirs, generator.Config{}, out, 1)
+	require.Nil(t, err)
+	require.Equal(t, generated, 0)
+	require.Equal(t, len(out.Stale), 0)
+
+	// A type that no package declares is not skipped silently.
+	out = &Outputs{Check: true, Diff: new(bytes.Buffer)}
+	typo := generator.Config{Types: []generator.TypeOptions{{Name: "Color"}, {Name: "Typo"}}}
+	_, err = runPackages(context.Background(), dirs, typo, out, 2)
+	require.Equal(t, err.Error(), "no package declares type Typo")
+
+	// A pattern matching a single package is generated from its directory,
+	// while files are kept as they are.
+	patterns := []string{"./testdata/multi/a/..."}
+	dirs = listPackages(patterns, nil)
+	require.Equal(t, dirs, []string{filepath.FromSlash("./testdata/multi/a")})
+	require.Equal(t, packagePatterns(patterns, dirs), dirs)
+	require.Equal(t, packagePatterns([]string{"testdata/multi/a/a.go"}, dirs), []string{"testdata/multi/a/a.go"})
+	cfg.Patterns = packagePatterns(patterns, dirs)
+	files, err := generator.Generate(context.Background(), cfg)
+	require.Nil(t, err)
+	require.Contains(t, string(files[filepath.Join("testdata", "multi", "a", "color_string.go")]), "func (i Color) Code() string {")
+}
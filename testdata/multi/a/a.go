@@ -0,0 +1,8 @@
+package a
+
+type Color int32
+
+const (
+	Red   Color = iota // red 红
+	Green              // green 绿
+)
@@ -0,0 +1,5 @@
+package b
+
+type Size int32
+
+const Small Size = 0 // small 小